@@ -0,0 +1,48 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// A LastError is the last error Gitlab returned while reconciling a managed
+// resource.
+type LastError struct {
+	// Reason classifies the error, e.g. ValidationFailed or Forbidden.
+	Reason string `json:"reason"`
+
+	// Message of the error.
+	Message string `json:"message"`
+
+	// FieldErrors are the validation messages Gitlab returned for individual
+	// fields of the request.
+	// +optional
+	FieldErrors []FieldError `json:"fieldErrors,omitempty"`
+
+	// Time the error was returned.
+	Time metav1.Time `json:"time"`
+}
+
+// A FieldError holds the validation messages of a single field.
+type FieldError struct {
+	// Field the messages refer to.
+	Field string `json:"field"`
+
+	// Messages describing why the value of the field was rejected.
+	Messages []string `json:"messages"`
+}
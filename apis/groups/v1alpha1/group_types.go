@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
 )
 
 // VisibilityValue represents a visibility level within GitLab.
@@ -128,7 +130,10 @@ type GroupParameters struct {
 	// +optional
 	RequestAccessEnabled *bool `json:"requestAccessEnabled,omitempty"`
 
-	// The parent group ID for creating nested group.
+	// The parent group ID for creating nested group. Changing it transfers
+	// the group to the new parent group, removing it or setting it to 0
+	// makes the group a top-level group. Groups adopted by their full path
+	// keep their parent group if it is not set.
 	// +optional
 	ParentID *int `json:"parentId,omitempty"`
 
@@ -234,6 +239,7 @@ type GroupObservation struct {
 	WebURL              *string                       `json:"webUrl,omitempty"`
 	FullName            *string                       `json:"fullName,omitempty"`
 	FullPath            *string                       `json:"fullPath,omitempty"`
+	ParentID            *int                          `json:"parentId,omitempty"`
 	Statistics          *StorageStatistics            `json:"statistics,omitempty"`
 	CustomAttributes    []CustomAttribute             `json:"customAttributes,omitempty"`
 	LDAPCN              *string                       `json:"ldapCn,omitempty"`
@@ -242,6 +248,11 @@ type GroupObservation struct {
 	MarkedForDeletionOn *metav1.Time                  `json:"markedForDeletionOn,omitempty"`
 	CreatedAt           *metav1.Time                  `json:"createdAt,omitempty"`
 	SharedWithGroups    []SharedWithGroupsObservation `json:"sharedWithGroups,omitempty"`
+
+	// LastError is the last error Gitlab returned while creating or
+	// updating the resource. It is cleared once an update succeeds.
+	// +optional
+	LastError *common.LastError `json:"lastError,omitempty"`
 }
 
 // SharedWithGroupsObservation is the observed state of a SharedWithGroups.
@@ -272,6 +283,7 @@ type GroupStatus struct {
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".status.atProvider.ID"
+// +kubebuilder:printcolumn:name="FULL-PATH",type="string",JSONPath=".status.atProvider.fullPath"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
 type Group struct {
@@ -21,6 +21,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -279,6 +280,11 @@ func (in *GroupObservation) DeepCopyInto(out *GroupObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ParentID != nil {
+		in, out := &in.ParentID, &out.ParentID
+		*out = new(int)
+		**out = **in
+	}
 	if in.Statistics != nil {
 		in, out := &in.Statistics, &out.Statistics
 		*out = new(StorageStatistics)
@@ -319,6 +325,11 @@ func (in *GroupObservation) DeepCopyInto(out *GroupObservation) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(common.LastError)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupObservation.
@@ -817,6 +828,26 @@ func (in *VariableList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VariableObservation) DeepCopyInto(out *VariableObservation) {
+	*out = *in
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(common.LastError)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableObservation.
+func (in *VariableObservation) DeepCopy() *VariableObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(VariableObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VariableParameters) DeepCopyInto(out *VariableParameters) {
 	*out = *in
@@ -903,6 +934,7 @@ func (in *VariableSpec) DeepCopy() *VariableSpec {
 func (in *VariableStatus) DeepCopyInto(out *VariableStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableStatus.
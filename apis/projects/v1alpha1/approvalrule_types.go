@@ -0,0 +1,189 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
+)
+
+// ApprovalRuleGroup is a group whose members are eligible to approve.
+// At least one of the fields [GroupID, GroupIDRef, GroupIDSelector] must be set.
+type ApprovalRuleGroup struct {
+	// GroupID is the ID of the group.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+$`
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1.Group
+	GroupID *string `json:"groupId,omitempty"`
+
+	// GroupIDRef is a reference to a group to retrieve its ID.
+	// +optional
+	GroupIDRef *xpv1.Reference `json:"groupIdRef,omitempty"`
+
+	// GroupIDSelector selects reference to a group to retrieve its ID.
+	// +optional
+	GroupIDSelector *xpv1.Selector `json:"groupIdSelector,omitempty"`
+}
+
+// ApprovalRuleProtectedBranch is a protected branch an approval rule is
+// scoped to.
+// At least one of the fields [ProtectedBranchID, ProtectedBranchIDRef,
+// ProtectedBranchIDSelector] must be set.
+type ApprovalRuleProtectedBranch struct {
+	// ProtectedBranchID is the ID of the protected branch.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+$`
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.ProtectedBranch
+	// +crossplane:generate:reference:extractor=ProtectedBranchID()
+	ProtectedBranchID *string `json:"protectedBranchId,omitempty"`
+
+	// ProtectedBranchIDRef is a reference to a ProtectedBranch to retrieve
+	// its ID.
+	// +optional
+	ProtectedBranchIDRef *xpv1.Reference `json:"protectedBranchIdRef,omitempty"`
+
+	// ProtectedBranchIDSelector selects reference to a ProtectedBranch to
+	// retrieve its ID.
+	// +optional
+	ProtectedBranchIDSelector *xpv1.Selector `json:"protectedBranchIdSelector,omitempty"`
+}
+
+// ApprovalRuleParameters define the desired state of a project-level merge
+// request approval rule.
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#project-level-mr-approvals
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type ApprovalRuleParameters struct {
+	// ProjectID is the ID of the project the rule belongs to.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// Name of the approval rule.
+	Name string `json:"name"`
+
+	// ApprovalsRequired is the number of approvals required by the rule.
+	// +kubebuilder:validation:Minimum=0
+	ApprovalsRequired int `json:"approvalsRequired"`
+
+	// RuleType is the type of the rule. any_approver is a pre-configured
+	// rule that any eligible user can satisfy. Late-initialized from Gitlab
+	// when not set.
+	// +kubebuilder:validation:Enum=regular;any_approver
+	// +optional
+	// +immutable
+	RuleType *string `json:"ruleType,omitempty"`
+
+	// UserIDs are the IDs of the users eligible to approve. Users missing
+	// from the list are removed from the rule.
+	// +optional
+	UserIDs []int `json:"userIds,omitempty"`
+
+	// Groups are the groups whose members are eligible to approve. Groups
+	// missing from the list are removed from the rule.
+	// +optional
+	Groups []ApprovalRuleGroup `json:"groups,omitempty"`
+
+	// ProtectedBranches are the protected branches the rule is scoped to.
+	// The rule applies to all branches if the list is empty.
+	// +optional
+	ProtectedBranches []ApprovalRuleProtectedBranch `json:"protectedBranches,omitempty"`
+
+	// AppliesToAllProtectedBranches scopes the rule to all protected
+	// branches of the project. Late-initialized from Gitlab when not set.
+	// +optional
+	AppliesToAllProtectedBranches *bool `json:"appliesToAllProtectedBranches,omitempty"`
+}
+
+// An ApprovalRuleSpec defines the desired state of a project-level merge
+// request approval rule.
+type ApprovalRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ApprovalRuleParameters `json:"forProvider"`
+}
+
+// ApprovalRuleObservation is the observed state of a project-level merge
+// request approval rule.
+type ApprovalRuleObservation struct {
+	// ID of the approval rule.
+	// +optional
+	ID *int `json:"id,omitempty"`
+
+	// EligibleApproverIDs are the IDs of all users that can approve,
+	// including the members of the rule's groups.
+	// +optional
+	EligibleApproverIDs []int `json:"eligibleApproverIds,omitempty"`
+
+	// ContainsHiddenGroups is true if the rule contains groups the provider
+	// cannot see.
+	// +optional
+	ContainsHiddenGroups bool `json:"containsHiddenGroups,omitempty"`
+
+	// LastError is the last error Gitlab returned while creating or updating
+	// the approval rule. It is cleared once an update succeeds.
+	// +optional
+	LastError *common.LastError `json:"lastError,omitempty"`
+}
+
+// An ApprovalRuleStatus represents the observed state of a project-level
+// merge request approval rule.
+type ApprovalRuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ApprovalRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ApprovalRule is a managed resource that represents a project-level merge
+// request approval rule.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="RULE",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="APPROVALS",type="integer",JSONPath=".spec.forProvider.approvalsRequired"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type ApprovalRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApprovalRuleSpec   `json:"spec"`
+	Status ApprovalRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ApprovalRuleList contains a list of ApprovalRule items.
+type ApprovalRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ApprovalRule `json:"items"`
+}
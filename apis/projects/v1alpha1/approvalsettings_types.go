@@ -0,0 +1,127 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
+)
+
+// ApprovalSettingsParameters define the desired merge request approval
+// settings of a Gitlab project. A project has exactly one set of approval
+// settings; deleting the resource leaves them unchanged in Gitlab.
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#change-configuration
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type ApprovalSettingsParameters struct {
+	// ProjectID is the ID of the project the settings apply to.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// ResetApprovalsOnPush removes all approvals from a merge request when
+	// new commits are pushed to it.
+	// +optional
+	ResetApprovalsOnPush *bool `json:"resetApprovalsOnPush,omitempty"`
+
+	// SelectiveCodeOwnerRemovals only removes the approvals of code owners
+	// whose files changed when new commits are pushed.
+	// +optional
+	SelectiveCodeOwnerRemovals *bool `json:"selectiveCodeOwnerRemovals,omitempty"`
+
+	// MergeRequestsAuthorApproval allows the author of a merge request to
+	// approve it. Set it to false to prevent author approval.
+	// +optional
+	MergeRequestsAuthorApproval *bool `json:"mergeRequestsAuthorApproval,omitempty"`
+
+	// MergeRequestsDisableCommittersApproval prevents users who committed to
+	// a merge request from approving it.
+	// +optional
+	MergeRequestsDisableCommittersApproval *bool `json:"mergeRequestsDisableCommittersApproval,omitempty"`
+
+	// RequirePasswordToApprove requires approvers to enter their password.
+	// +optional
+	RequirePasswordToApprove *bool `json:"requirePasswordToApprove,omitempty"`
+
+	// DisableOverridingApproversPerMergeRequest prevents approval rules from
+	// being edited in merge requests.
+	// +optional
+	DisableOverridingApproversPerMergeRequest *bool `json:"disableOverridingApproversPerMergeRequest,omitempty"`
+}
+
+// An ApprovalSettingsSpec defines the desired merge request approval settings
+// of a Gitlab project.
+type ApprovalSettingsSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ApprovalSettingsParameters `json:"forProvider"`
+}
+
+// ApprovalSettingsObservation is the observed merge request approval settings
+// of a Gitlab project.
+type ApprovalSettingsObservation struct {
+	// LastError is the last error Gitlab returned while changing the approval
+	// settings. It is cleared once an update succeeds.
+	// +optional
+	LastError *common.LastError `json:"lastError,omitempty"`
+}
+
+// An ApprovalSettingsStatus represents the observed merge request approval
+// settings of a Gitlab project.
+type ApprovalSettingsStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ApprovalSettingsObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ApprovalSettings is a managed resource that represents the merge request
+// approval settings of a Gitlab project.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type ApprovalSettings struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApprovalSettingsSpec   `json:"spec"`
+	Status ApprovalSettingsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ApprovalSettingsList contains a list of ApprovalSettings items.
+type ApprovalSettingsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ApprovalSettings `json:"items"`
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// BranchParameters define the desired state of a Gitlab repository branch.
+// https://docs.gitlab.com/ee/api/branches.html
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type BranchParameters struct {
+	// ProjectID is the ID of the project to create the branch in.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// Name of the branch, e.g. develop or release/1.0.
+	// +immutable
+	Name string `json:"name"`
+
+	// Ref is the branch name, tag or commit SHA to create the branch from.
+	// It is only used to create the branch and ignored once the branch
+	// exists.
+	Ref string `json:"ref"`
+
+	// DeleteOnRemoval deletes the branch from Gitlab when the Branch is
+	// deleted with the Delete deletion policy. Branches may hold work that
+	// exists nowhere else, so they are kept in Gitlab by default.
+	// +optional
+	DeleteOnRemoval *bool `json:"deleteOnRemoval,omitempty"`
+}
+
+// A BranchSpec defines the desired state of a Gitlab repository branch.
+type BranchSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BranchParameters `json:"forProvider"`
+}
+
+// BranchObservation is the observed state of a Gitlab repository branch.
+type BranchObservation struct {
+	// CommitSHA is the SHA of the commit the branch points to.
+	// +optional
+	CommitSHA string `json:"commitSha,omitempty"`
+
+	// Protected is true if the branch is protected.
+	// +optional
+	Protected bool `json:"protected,omitempty"`
+
+	// Default is true if the branch is the default branch of the project.
+	// +optional
+	Default bool `json:"default,omitempty"`
+
+	// Merged is true if the branch has been merged into the default branch.
+	// +optional
+	Merged bool `json:"merged,omitempty"`
+
+	// WebURL of the branch.
+	// +optional
+	WebURL string `json:"webUrl,omitempty"`
+}
+
+// A BranchStatus represents the observed state of a Gitlab repository branch.
+type BranchStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BranchObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Branch is a managed resource that represents a Gitlab repository branch.
+// Branches may hold work that exists nowhere else, so the branch is only
+// deleted from Gitlab if deleteOnRemoval is set.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="BRANCH",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="SHA",type="string",JSONPath=".status.atProvider.commitSha",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type Branch struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BranchSpec   `json:"spec"`
+	Status BranchStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BranchList contains a list of Branch items.
+type BranchList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Branch `json:"items"`
+}
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
 )
 
 // AccessControlValue represents an access control value within GitLab,
@@ -66,6 +68,24 @@ const (
 	RebaseMerge        MergeMethodValue = "rebase_merge"
 )
 
+// DeletionMode determines what happens to a project in Gitlab when its
+// managed resource is deleted.
+type DeletionMode string
+
+// List of available deletion modes.
+const (
+	// DeletionModeArchive archives the project and leaves it in place.
+	DeletionModeArchive DeletionMode = "Archive"
+
+	// DeletionModeDelayed deletes the project, which Gitlab instances with
+	// delayed project deletion only mark for deletion.
+	DeletionModeDelayed DeletionMode = "Delayed"
+
+	// DeletionModePermanent deletes the project immediately, even if
+	// Gitlab would otherwise only mark it for deletion.
+	DeletionModePermanent DeletionMode = "Permanent"
+)
+
 // UserIdentity represents a user identity.
 type UserIdentity struct {
 	Provider  string `json:"provider"`
@@ -155,10 +175,17 @@ type ProjectParameters struct {
 	AllowMergeOnSkippedPipeline *bool `json:"allowMergeOnSkippedPipeline,omitempty"`
 
 	// How many approvers should approve merge request by default.
-	// To configure approval rules, see Merge request approvals API.
+	// Gitlab deprecated this setting in favor of approval rules, which are
+	// managed with ApprovalRule resources.
 	// +optional
 	ApprovalsBeforeMerge *int `json:"approvalsBeforeMerge,omitempty"`
 
+	// Archived makes the project read-only. The archived state of the
+	// project is left alone if this is not set. Archived projects are
+	// unarchived for as long as it takes to update them.
+	// +optional
+	Archived *bool `json:"archived,omitempty"`
+
 	// Auto-cancel pending pipelines. This isn’t a boolean, but enabled/disabled.
 	// +optional
 	AutoCancelPendingPipelines *string `json:"autoCancelPendingPipelines,omitempty"`
@@ -216,6 +243,14 @@ type ProjectParameters struct {
 	// +optional
 	DefaultBranch *string `json:"defaultBranch,omitempty"`
 
+	// DeletionMode determines what happens to the project when this
+	// resource is deleted. Archive archives the project, Delayed deletes it
+	// subject to the delayed project deletion of the Gitlab instance and
+	// Permanent deletes it immediately. Defaults to Delayed.
+	// +kubebuilder:validation:Enum=Archive;Delayed;Permanent
+	// +optional
+	DeletionMode *DeletionMode `json:"deletionMode,omitempty"`
+
 	// Short project description.
 	// +optional
 	Description *string `json:"description,omitempty"`
@@ -370,6 +405,12 @@ type ProjectParameters struct {
 	// +optional
 	SharedRunnersEnabled *bool `json:"sharedRunnersEnabled,omitempty"`
 
+	// SharedWithGroups are the groups the project is shared with. The
+	// project is unshared from all other groups. If this is not set, the
+	// shares of the project are left alone.
+	// +optional
+	SharedWithGroups []SharedWithGroupsParameters `json:"sharedWithGroups,omitempty"`
+
 	// One of disabled, private, or enabled.
 	// +optional
 	SnippetsAccessLevel *AccessControlValue `json:"snippetsAccessLevel,omitempty"`
@@ -491,6 +532,32 @@ type CustomAttribute struct {
 	Value string `json:"value"`
 }
 
+// SharedWithGroupsParameters represents a group a project is shared with.
+// At least one of the fields [GroupID, GroupIDRef, GroupIDSelector] must be set.
+type SharedWithGroupsParameters struct {
+	// The ID of the group to share with.
+	// +optional
+	GroupID *int `json:"groupId,omitempty"`
+
+	// GroupIDRef is a reference to a group to retrieve its ID.
+	// +optional
+	GroupIDRef *xpv1.Reference `json:"groupIdRef,omitempty"`
+
+	// GroupIDSelector selects reference to a group to retrieve its ID.
+	// +optional
+	GroupIDSelector *xpv1.Selector `json:"groupIdSelector,omitempty"`
+
+	// The role (access_level) to grant the group
+	// https://docs.gitlab.com/ee/api/members.html#roles
+	// +required
+	GroupAccessLevel int `json:"groupAccessLevel"`
+
+	// Share expiration date in ISO 8601 format: 2016-09-26
+	// Changing it replaces the share of the group.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+}
+
 // SharedWithGroups struct used in gitlab project
 type SharedWithGroups struct {
 	GroupID          int    `json:"groupID,omitempty"`
@@ -538,6 +605,11 @@ type ProjectObservation struct {
 	Statistics                *ProjectStatistics         `json:"statistics,omitempty"`
 	WebURL                    string                     `json:"webUrl,omitempty"`
 	WikiEnabled               bool                       `json:"wikiEnabled,omitempty"`
+
+	// LastError is the last error Gitlab returned while creating or
+	// updating the resource. It is cleared once an update succeeds.
+	// +optional
+	LastError *common.LastError `json:"lastError,omitempty"`
 }
 
 // A ProjectSpec defines the desired state of a Gitlab Project.
@@ -0,0 +1,173 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
+)
+
+// BranchPermission allows a single user, group or deploy key to act on a
+// protected branch regardless of its role in the project. Exactly one of
+// UserID, GroupID or DeployKeyID must be set.
+// +kubebuilder:validation:MinProperties=1
+// +kubebuilder:validation:MaxProperties=1
+type BranchPermission struct {
+	// UserID is the ID of the allowed user.
+	// +optional
+	UserID *int `json:"userId,omitempty"`
+
+	// GroupID is the ID of the allowed group.
+	// +optional
+	GroupID *int `json:"groupId,omitempty"`
+
+	// DeployKeyID is the ID of the allowed deploy key. Deploy keys can only
+	// be allowed to push.
+	// +optional
+	DeployKeyID *int `json:"deployKeyId,omitempty"`
+}
+
+// ProtectedBranchParameters define the desired state of a Gitlab protected
+// branch.
+// https://docs.gitlab.com/ee/api/protected_branches.html
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type ProtectedBranchParameters struct {
+	// ProjectID is the ID of the project to protect the branch in.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// Name of the branch or wildcard, e.g. main or release/*.
+	// +immutable
+	Name string `json:"name"`
+
+	// PushAccessLevel is the role allowed to push.
+	// Valid values are 0 (no access), 30 (developer), 40 (maintainer) and
+	// 60 (admin). Late-initialized from Gitlab when not set.
+	// +kubebuilder:validation:Enum=0;30;40;60
+	// +optional
+	PushAccessLevel *AccessLevelValue `json:"pushAccessLevel,omitempty"`
+
+	// MergeAccessLevel is the role allowed to merge.
+	// Valid values are 0 (no access), 30 (developer), 40 (maintainer) and
+	// 60 (admin). Late-initialized from Gitlab when not set.
+	// +kubebuilder:validation:Enum=0;30;40;60
+	// +optional
+	MergeAccessLevel *AccessLevelValue `json:"mergeAccessLevel,omitempty"`
+
+	// UnprotectAccessLevel is the role allowed to unprotect the branch.
+	// Valid values are 30 (developer), 40 (maintainer) and 60 (admin).
+	// Late-initialized from Gitlab when not set.
+	// +kubebuilder:validation:Enum=30;40;60
+	// +optional
+	UnprotectAccessLevel *AccessLevelValue `json:"unprotectAccessLevel,omitempty"`
+
+	// AllowedToPush are the users, groups and deploy keys allowed to push in
+	// addition to PushAccessLevel. Allowances missing from the list are
+	// removed.
+	// +optional
+	AllowedToPush []BranchPermission `json:"allowedToPush,omitempty"`
+
+	// AllowedToMerge are the users and groups allowed to merge in addition
+	// to MergeAccessLevel. Allowances missing from the list are removed.
+	// +optional
+	AllowedToMerge []BranchPermission `json:"allowedToMerge,omitempty"`
+
+	// AllowedToUnprotect are the users and groups allowed to unprotect the
+	// branch in addition to UnprotectAccessLevel. Allowances missing from the
+	// list are removed.
+	// +optional
+	AllowedToUnprotect []BranchPermission `json:"allowedToUnprotect,omitempty"`
+
+	// AllowForcePush allows force pushes by everyone allowed to push.
+	// +optional
+	AllowForcePush *bool `json:"allowForcePush,omitempty"`
+
+	// CodeOwnerApprovalRequired prevents pushes to the branch if it matches
+	// an item in the CODEOWNERS file.
+	// +optional
+	CodeOwnerApprovalRequired *bool `json:"codeOwnerApprovalRequired,omitempty"`
+}
+
+// A ProtectedBranchSpec defines the desired state of a Gitlab protected
+// branch.
+type ProtectedBranchSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProtectedBranchParameters `json:"forProvider"`
+}
+
+// ProtectedBranchObservation is the observed state of a Gitlab protected
+// branch.
+type ProtectedBranchObservation struct {
+	// ID of the protected branch.
+	// +optional
+	ID *int `json:"id,omitempty"`
+
+	// LastError is the last error Gitlab returned while protecting or
+	// updating the branch. It is cleared once an update succeeds.
+	// +optional
+	LastError *common.LastError `json:"lastError,omitempty"`
+}
+
+// A ProtectedBranchStatus represents the observed state of a Gitlab
+// protected branch.
+type ProtectedBranchStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProtectedBranchObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProtectedBranch is a managed resource that represents a Gitlab protected
+// branch.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="BRANCH",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type ProtectedBranch struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProtectedBranchSpec   `json:"spec"`
+	Status ProtectedBranchStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProtectedBranchList contains a list of ProtectedBranch items.
+type ProtectedBranchList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProtectedBranch `json:"items"`
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
+)
+
+// TagAccessLevel allows a role, user, group or deploy key to create matching
+// tags. Exactly one of AccessLevel, UserID, GroupID or DeployKeyID must be
+// set.
+// +kubebuilder:validation:MinProperties=1
+// +kubebuilder:validation:MaxProperties=1
+type TagAccessLevel struct {
+	// AccessLevel is the allowed role.
+	// Valid values are 0 (no access), 30 (developer), 40 (maintainer) and
+	// 60 (admin).
+	// +kubebuilder:validation:Enum=0;30;40;60
+	// +optional
+	AccessLevel *AccessLevelValue `json:"accessLevel,omitempty"`
+
+	// UserID is the ID of the allowed user.
+	// +optional
+	UserID *int `json:"userId,omitempty"`
+
+	// GroupID is the ID of the allowed group.
+	// +optional
+	GroupID *int `json:"groupId,omitempty"`
+
+	// DeployKeyID is the ID of the allowed deploy key.
+	// +optional
+	DeployKeyID *int `json:"deployKeyId,omitempty"`
+}
+
+// ProtectedTagParameters define the desired state of a Gitlab protected tag.
+// https://docs.gitlab.com/ee/api/protected_tags.html
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type ProtectedTagParameters struct {
+	// ProjectID is the ID of the project to protect the tags in.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// Name of the tag or wildcard, e.g. v*.
+	// +immutable
+	Name string `json:"name"`
+
+	// CreateAccessLevels are the roles, users, groups and deploy keys
+	// allowed to create matching tags. Gitlab cannot edit a protected tag,
+	// so changing them unprotects and protects the tags again. Without a
+	// role no role is allowed to create the tags, and roles seen in Gitlab
+	// are ignored. Late-initialized from Gitlab when not set.
+	// +optional
+	CreateAccessLevels []TagAccessLevel `json:"createAccessLevels,omitempty"`
+}
+
+// A ProtectedTagSpec defines the desired state of a Gitlab protected tag.
+type ProtectedTagSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProtectedTagParameters `json:"forProvider"`
+}
+
+// ProtectedTagObservation is the observed state of a Gitlab protected tag.
+type ProtectedTagObservation struct {
+	// LastError is the last error Gitlab returned while protecting the tags.
+	// It is cleared once an update succeeds.
+	// +optional
+	LastError *common.LastError `json:"lastError,omitempty"`
+}
+
+// A ProtectedTagStatus represents the observed state of a Gitlab protected
+// tag.
+type ProtectedTagStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProtectedTagObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProtectedTag is a managed resource that represents a Gitlab protected
+// tag.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TAG",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type ProtectedTag struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProtectedTagSpec   `json:"spec"`
+	Status ProtectedTagStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProtectedTagList contains a list of ProtectedTag items.
+type ProtectedTagList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProtectedTag `json:"items"`
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
+)
+
+// PushRulesParameters define the desired state of the push rules of a Gitlab
+// project. A project has at most one set of push rules.
+// https://docs.gitlab.com/ee/api/projects.html#push-rules
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type PushRulesParameters struct {
+	// ProjectID is the ID of the project the push rules apply to.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its projectId.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its projectId.
+	// +optional
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// CommitMessageRegex is a regular expression all commit messages must
+	// match, e.g. Fixed \d+\..*.
+	// +optional
+	CommitMessageRegex *string `json:"commitMessageRegex,omitempty"`
+
+	// CommitMessageNegativeRegex is a regular expression no commit message
+	// may match, e.g. ssh\:\/\/.
+	// +optional
+	CommitMessageNegativeRegex *string `json:"commitMessageNegativeRegex,omitempty"`
+
+	// BranchNameRegex is a regular expression all branch names must match,
+	// e.g. (feature|hotfix)\/*.
+	// +optional
+	BranchNameRegex *string `json:"branchNameRegex,omitempty"`
+
+	// AuthorEmailRegex is a regular expression all commit author emails must
+	// match, e.g. @my-company.com$.
+	// +optional
+	AuthorEmailRegex *string `json:"authorEmailRegex,omitempty"`
+
+	// FileNameRegex is a regular expression no committed file name may
+	// match, e.g. (jar|exe)$.
+	// +optional
+	FileNameRegex *string `json:"fileNameRegex,omitempty"`
+
+	// MaxFileSize is the maximum size of a committed file in MB. 0 allows
+	// files of any size.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxFileSize *int `json:"maxFileSize,omitempty"`
+
+	// DenyDeleteTag denies deleting a tag.
+	// +optional
+	DenyDeleteTag *bool `json:"denyDeleteTag,omitempty"`
+
+	// MemberCheck restricts commits to existing Gitlab users.
+	// +optional
+	MemberCheck *bool `json:"memberCheck,omitempty"`
+
+	// PreventSecrets rejects files that are likely to contain secrets.
+	// +optional
+	PreventSecrets *bool `json:"preventSecrets,omitempty"`
+
+	// CommitCommitterCheck only accepts commits whose committer email is one
+	// of the pushing user's verified emails.
+	// +optional
+	CommitCommitterCheck *bool `json:"commitCommitterCheck,omitempty"`
+
+	// RejectUnsignedCommits rejects commits that are not signed.
+	// +optional
+	RejectUnsignedCommits *bool `json:"rejectUnsignedCommits,omitempty"`
+}
+
+// A PushRulesSpec defines the desired state of the push rules of a Gitlab
+// project.
+type PushRulesSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PushRulesParameters `json:"forProvider"`
+}
+
+// PushRulesObservation is the observed state of the push rules of a Gitlab
+// project.
+type PushRulesObservation struct {
+	// ID of the push rules.
+	// +optional
+	ID *int `json:"id,omitempty"`
+
+	// CreatedAt is the time the push rules were added to the project.
+	// +optional
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// LastError is the last error Gitlab returned while adding or editing the
+	// push rules. It is cleared once an update succeeds.
+	// +optional
+	LastError *common.LastError `json:"lastError,omitempty"`
+}
+
+// A PushRulesStatus represents the observed state of the push rules of a
+// Gitlab project.
+type PushRulesStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PushRulesObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PushRules is a managed resource that represents the push rules of a Gitlab
+// project.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type PushRules struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PushRulesSpec   `json:"spec"`
+	Status PushRulesStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PushRulesList contains a list of PushRules items.
+type PushRulesList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PushRules `json:"items"`
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -93,6 +94,25 @@ func (mg *Project) ResolveReferences(ctx context.Context, c client.Reader) error
 	mg.Spec.ForProvider.NamespaceID = toPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.NamespaceIDRef = rsp.ResolvedReference
 
+	// resolve spec.forProvider.sharedWithGroups[*].groupIdRef
+	for i := range mg.Spec.ForProvider.SharedWithGroups {
+		sh := &mg.Spec.ForProvider.SharedWithGroups[i]
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: fromPtrValue(sh.GroupID),
+			Reference:    sh.GroupIDRef,
+			Selector:     sh.GroupIDSelector,
+			To:           reference.To{Managed: &v1alpha1.Group{}, List: &v1alpha1.GroupList{}},
+			Extract:      reference.ExternalName(),
+		})
+
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.sharedWithGroups[%d].groupId", i)
+		}
+
+		sh.GroupID = toPtrValue(rsp.ResolvedValue)
+		sh.GroupIDRef = rsp.ResolvedReference
+	}
+
 	return nil
 }
 
@@ -164,3 +184,15 @@ func (mg *Variable) ResolveReferences(ctx context.Context, c client.Reader) erro
 
 	return nil
 }
+
+// ProtectedBranchID extracts the Gitlab ID of a ProtectedBranch, which is
+// only known once it has been observed.
+func ProtectedBranchID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		pb, ok := mg.(*ProtectedBranch)
+		if !ok {
+			return ""
+		}
+		return fromPtrValue(pb.Status.AtProvider.ID)
+	}
+}
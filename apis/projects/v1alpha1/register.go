@@ -101,6 +101,54 @@ var (
 	PipelineScheduleGroupVersionKind = SchemeGroupVersion.WithKind(PipelineScheduleKind)
 )
 
+// Protected Branch type metadata
+var (
+	ProtectedBranchKind             = reflect.TypeOf(ProtectedBranch{}).Name()
+	ProtectedBranchGroupKind        = schema.GroupKind{Group: Group, Kind: ProtectedBranchKind}.String()
+	ProtectedBranchKindAPIVersion   = ProtectedBranchKind + "." + SchemeGroupVersion.String()
+	ProtectedBranchGroupVersionKind = SchemeGroupVersion.WithKind(ProtectedBranchKind)
+)
+
+// Protected Tag type metadata
+var (
+	ProtectedTagKind             = reflect.TypeOf(ProtectedTag{}).Name()
+	ProtectedTagGroupKind        = schema.GroupKind{Group: Group, Kind: ProtectedTagKind}.String()
+	ProtectedTagKindAPIVersion   = ProtectedTagKind + "." + SchemeGroupVersion.String()
+	ProtectedTagGroupVersionKind = SchemeGroupVersion.WithKind(ProtectedTagKind)
+)
+
+// Push Rules type metadata
+var (
+	PushRulesKind             = reflect.TypeOf(PushRules{}).Name()
+	PushRulesGroupKind        = schema.GroupKind{Group: Group, Kind: PushRulesKind}.String()
+	PushRulesKindAPIVersion   = PushRulesKind + "." + SchemeGroupVersion.String()
+	PushRulesGroupVersionKind = SchemeGroupVersion.WithKind(PushRulesKind)
+)
+
+// Approval Rule type metadata
+var (
+	ApprovalRuleKind             = reflect.TypeOf(ApprovalRule{}).Name()
+	ApprovalRuleGroupKind        = schema.GroupKind{Group: Group, Kind: ApprovalRuleKind}.String()
+	ApprovalRuleKindAPIVersion   = ApprovalRuleKind + "." + SchemeGroupVersion.String()
+	ApprovalRuleGroupVersionKind = SchemeGroupVersion.WithKind(ApprovalRuleKind)
+)
+
+// Approval Settings type metadata
+var (
+	ApprovalSettingsKind             = reflect.TypeOf(ApprovalSettings{}).Name()
+	ApprovalSettingsGroupKind        = schema.GroupKind{Group: Group, Kind: ApprovalSettingsKind}.String()
+	ApprovalSettingsKindAPIVersion   = ApprovalSettingsKind + "." + SchemeGroupVersion.String()
+	ApprovalSettingsGroupVersionKind = SchemeGroupVersion.WithKind(ApprovalSettingsKind)
+)
+
+// Branch type metadata
+var (
+	BranchKind             = reflect.TypeOf(Branch{}).Name()
+	BranchGroupKind        = schema.GroupKind{Group: Group, Kind: BranchKind}.String()
+	BranchKindAPIVersion   = BranchKind + "." + SchemeGroupVersion.String()
+	BranchGroupVersionKind = SchemeGroupVersion.WithKind(BranchKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Project{}, &ProjectList{})
 	SchemeBuilder.Register(&Hook{}, &HookList{})
@@ -110,4 +158,10 @@ func init() {
 	SchemeBuilder.Register(&DeployKey{}, &DeployKeyList{})
 	SchemeBuilder.Register(&AccessToken{}, &AccessTokenList{})
 	SchemeBuilder.Register(&PipelineSchedule{}, &PipelineScheduleList{})
+	SchemeBuilder.Register(&ProtectedBranch{}, &ProtectedBranchList{})
+	SchemeBuilder.Register(&ProtectedTag{}, &ProtectedTagList{})
+	SchemeBuilder.Register(&PushRules{}, &PushRulesList{})
+	SchemeBuilder.Register(&ApprovalRule{}, &ApprovalRuleList{})
+	SchemeBuilder.Register(&ApprovalSettings{}, &ApprovalSettingsList{})
+	SchemeBuilder.Register(&Branch{}, &BranchList{})
 }
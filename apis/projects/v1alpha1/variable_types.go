@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
 )
 
 // VariableType indicates the type of the GitLab CI variable.
@@ -96,10 +98,19 @@ type VariableSpec struct {
 	ForProvider       VariableParameters `json:"forProvider"`
 }
 
+// VariableObservation is the observed state of a Gitlab Project CI Variable.
+type VariableObservation struct {
+	// LastError is the last error Gitlab returned while creating or updating
+	// the variable. It is cleared once an update succeeds.
+	// +optional
+	LastError *common.LastError `json:"lastError,omitempty"`
+}
+
 // A VariableStatus represents the observed state of a Gitlab Project CI
 // Variable.
 type VariableStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VariableObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -21,6 +21,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -183,142 +184,88 @@ func (in *AccessTokenStatus) DeepCopy() *AccessTokenStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerExpirationPolicy) DeepCopyInto(out *ContainerExpirationPolicy) {
+func (in *ApprovalRule) DeepCopyInto(out *ApprovalRule) {
 	*out = *in
-	if in.NextRunAt != nil {
-		in, out := &in.NextRunAt, &out.NextRunAt
-		*out = (*in).DeepCopy()
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerExpirationPolicy.
-func (in *ContainerExpirationPolicy) DeepCopy() *ContainerExpirationPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalRule.
+func (in *ApprovalRule) DeepCopy() *ApprovalRule {
 	if in == nil {
 		return nil
 	}
-	out := new(ContainerExpirationPolicy)
+	out := new(ApprovalRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApprovalRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerExpirationPolicyAttributes) DeepCopyInto(out *ContainerExpirationPolicyAttributes) {
+func (in *ApprovalRuleGroup) DeepCopyInto(out *ApprovalRuleGroup) {
 	*out = *in
-	if in.Cadence != nil {
-		in, out := &in.Cadence, &out.Cadence
-		*out = new(string)
-		**out = **in
-	}
-	if in.KeepN != nil {
-		in, out := &in.KeepN, &out.KeepN
-		*out = new(int)
-		**out = **in
-	}
-	if in.OlderThan != nil {
-		in, out := &in.OlderThan, &out.OlderThan
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
 		*out = new(string)
 		**out = **in
 	}
-	if in.NameRegexDelete != nil {
-		in, out := &in.NameRegexDelete, &out.NameRegexDelete
-		*out = new(string)
-		**out = **in
-	}
-	if in.NameRegexKeep != nil {
-		in, out := &in.NameRegexKeep, &out.NameRegexKeep
-		*out = new(string)
-		**out = **in
-	}
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.NameRegex != nil {
-		in, out := &in.NameRegex, &out.NameRegex
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerExpirationPolicyAttributes.
-func (in *ContainerExpirationPolicyAttributes) DeepCopy() *ContainerExpirationPolicyAttributes {
-	if in == nil {
-		return nil
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(ContainerExpirationPolicyAttributes)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomAttribute) DeepCopyInto(out *CustomAttribute) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomAttribute.
-func (in *CustomAttribute) DeepCopy() *CustomAttribute {
-	if in == nil {
-		return nil
+	if in.GroupIDSelector != nil {
+		in, out := &in.GroupIDSelector, &out.GroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(CustomAttribute)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployKey) DeepCopyInto(out *DeployKey) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKey.
-func (in *DeployKey) DeepCopy() *DeployKey {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalRuleGroup.
+func (in *ApprovalRuleGroup) DeepCopy() *ApprovalRuleGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployKey)
+	out := new(ApprovalRuleGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DeployKey) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployKeyList) DeepCopyInto(out *DeployKeyList) {
+func (in *ApprovalRuleList) DeepCopyInto(out *ApprovalRuleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DeployKey, len(*in))
+		*out = make([]ApprovalRule, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyList.
-func (in *DeployKeyList) DeepCopy() *DeployKeyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalRuleList.
+func (in *ApprovalRuleList) DeepCopy() *ApprovalRuleList {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployKeyList)
+	out := new(ApprovalRuleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DeployKeyList) DeepCopyObject() runtime.Object {
+func (in *ApprovalRuleList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -326,31 +273,37 @@ func (in *DeployKeyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployKeyObservation) DeepCopyInto(out *DeployKeyObservation) {
+func (in *ApprovalRuleObservation) DeepCopyInto(out *ApprovalRuleObservation) {
 	*out = *in
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(int)
 		**out = **in
 	}
-	if in.CreatedAt != nil {
-		in, out := &in.CreatedAt, &out.CreatedAt
-		*out = (*in).DeepCopy()
+	if in.EligibleApproverIDs != nil {
+		in, out := &in.EligibleApproverIDs, &out.EligibleApproverIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(common.LastError)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyObservation.
-func (in *DeployKeyObservation) DeepCopy() *DeployKeyObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalRuleObservation.
+func (in *ApprovalRuleObservation) DeepCopy() *ApprovalRuleObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployKeyObservation)
+	out := new(ApprovalRuleObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployKeyParameters) DeepCopyInto(out *DeployKeyParameters) {
+func (in *ApprovalRuleParameters) DeepCopyInto(out *ApprovalRuleParameters) {
 	*out = *in
 	if in.ProjectID != nil {
 		in, out := &in.ProjectID, &out.ProjectID
@@ -367,64 +320,113 @@ func (in *DeployKeyParameters) DeepCopyInto(out *DeployKeyParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.CanPush != nil {
-		in, out := &in.CanPush, &out.CanPush
+	if in.RuleType != nil {
+		in, out := &in.RuleType, &out.RuleType
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserIDs != nil {
+		in, out := &in.UserIDs, &out.UserIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]ApprovalRuleGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ProtectedBranches != nil {
+		in, out := &in.ProtectedBranches, &out.ProtectedBranches
+		*out = make([]ApprovalRuleProtectedBranch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AppliesToAllProtectedBranches != nil {
+		in, out := &in.AppliesToAllProtectedBranches, &out.AppliesToAllProtectedBranches
 		*out = new(bool)
 		**out = **in
 	}
-	if in.ExpiresAt != nil {
-		in, out := &in.ExpiresAt, &out.ExpiresAt
-		*out = (*in).DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalRuleParameters.
+func (in *ApprovalRuleParameters) DeepCopy() *ApprovalRuleParameters {
+	if in == nil {
+		return nil
 	}
-	out.KeySecretRef = in.KeySecretRef
+	out := new(ApprovalRuleParameters)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyParameters.
-func (in *DeployKeyParameters) DeepCopy() *DeployKeyParameters {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalRuleProtectedBranch) DeepCopyInto(out *ApprovalRuleProtectedBranch) {
+	*out = *in
+	if in.ProtectedBranchID != nil {
+		in, out := &in.ProtectedBranchID, &out.ProtectedBranchID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProtectedBranchIDRef != nil {
+		in, out := &in.ProtectedBranchIDRef, &out.ProtectedBranchIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProtectedBranchIDSelector != nil {
+		in, out := &in.ProtectedBranchIDSelector, &out.ProtectedBranchIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalRuleProtectedBranch.
+func (in *ApprovalRuleProtectedBranch) DeepCopy() *ApprovalRuleProtectedBranch {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployKeyParameters)
+	out := new(ApprovalRuleProtectedBranch)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployKeySpec) DeepCopyInto(out *DeployKeySpec) {
+func (in *ApprovalRuleSpec) DeepCopyInto(out *ApprovalRuleSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeySpec.
-func (in *DeployKeySpec) DeepCopy() *DeployKeySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalRuleSpec.
+func (in *ApprovalRuleSpec) DeepCopy() *ApprovalRuleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployKeySpec)
+	out := new(ApprovalRuleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployKeyStatus) DeepCopyInto(out *DeployKeyStatus) {
+func (in *ApprovalRuleStatus) DeepCopyInto(out *ApprovalRuleStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyStatus.
-func (in *DeployKeyStatus) DeepCopy() *DeployKeyStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalRuleStatus.
+func (in *ApprovalRuleStatus) DeepCopy() *ApprovalRuleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployKeyStatus)
+	out := new(ApprovalRuleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployToken) DeepCopyInto(out *DeployToken) {
+func (in *ApprovalSettings) DeepCopyInto(out *ApprovalSettings) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -432,18 +434,18 @@ func (in *DeployToken) DeepCopyInto(out *DeployToken) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployToken.
-func (in *DeployToken) DeepCopy() *DeployToken {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalSettings.
+func (in *ApprovalSettings) DeepCopy() *ApprovalSettings {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployToken)
+	out := new(ApprovalSettings)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DeployToken) DeepCopyObject() runtime.Object {
+func (in *ApprovalSettings) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -451,31 +453,31 @@ func (in *DeployToken) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployTokenList) DeepCopyInto(out *DeployTokenList) {
+func (in *ApprovalSettingsList) DeepCopyInto(out *ApprovalSettingsList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DeployToken, len(*in))
+		*out = make([]ApprovalSettings, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenList.
-func (in *DeployTokenList) DeepCopy() *DeployTokenList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalSettingsList.
+func (in *ApprovalSettingsList) DeepCopy() *ApprovalSettingsList {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployTokenList)
+	out := new(ApprovalSettingsList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DeployTokenList) DeepCopyObject() runtime.Object {
+func (in *ApprovalSettingsList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -483,26 +485,31 @@ func (in *DeployTokenList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployTokenObservation) DeepCopyInto(out *DeployTokenObservation) {
+func (in *ApprovalSettingsObservation) DeepCopyInto(out *ApprovalSettingsObservation) {
 	*out = *in
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(common.LastError)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenObservation.
-func (in *DeployTokenObservation) DeepCopy() *DeployTokenObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalSettingsObservation.
+func (in *ApprovalSettingsObservation) DeepCopy() *ApprovalSettingsObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployTokenObservation)
+	out := new(ApprovalSettingsObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployTokenParameters) DeepCopyInto(out *DeployTokenParameters) {
+func (in *ApprovalSettingsParameters) DeepCopyInto(out *ApprovalSettingsParameters) {
 	*out = *in
 	if in.ProjectID != nil {
 		in, out := &in.ProjectID, &out.ProjectID
-		*out = new(int)
+		*out = new(string)
 		**out = **in
 	}
 	if in.ProjectIDRef != nil {
@@ -515,190 +522,162 @@ func (in *DeployTokenParameters) DeepCopyInto(out *DeployTokenParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ExpiresAt != nil {
-		in, out := &in.ExpiresAt, &out.ExpiresAt
-		*out = (*in).DeepCopy()
+	if in.ResetApprovalsOnPush != nil {
+		in, out := &in.ResetApprovalsOnPush, &out.ResetApprovalsOnPush
+		*out = new(bool)
+		**out = **in
 	}
-	if in.Username != nil {
-		in, out := &in.Username, &out.Username
-		*out = new(string)
+	if in.SelectiveCodeOwnerRemovals != nil {
+		in, out := &in.SelectiveCodeOwnerRemovals, &out.SelectiveCodeOwnerRemovals
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Scopes != nil {
-		in, out := &in.Scopes, &out.Scopes
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.MergeRequestsAuthorApproval != nil {
+		in, out := &in.MergeRequestsAuthorApproval, &out.MergeRequestsAuthorApproval
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MergeRequestsDisableCommittersApproval != nil {
+		in, out := &in.MergeRequestsDisableCommittersApproval, &out.MergeRequestsDisableCommittersApproval
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequirePasswordToApprove != nil {
+		in, out := &in.RequirePasswordToApprove, &out.RequirePasswordToApprove
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableOverridingApproversPerMergeRequest != nil {
+		in, out := &in.DisableOverridingApproversPerMergeRequest, &out.DisableOverridingApproversPerMergeRequest
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenParameters.
-func (in *DeployTokenParameters) DeepCopy() *DeployTokenParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalSettingsParameters.
+func (in *ApprovalSettingsParameters) DeepCopy() *ApprovalSettingsParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployTokenParameters)
+	out := new(ApprovalSettingsParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployTokenSpec) DeepCopyInto(out *DeployTokenSpec) {
+func (in *ApprovalSettingsSpec) DeepCopyInto(out *ApprovalSettingsSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenSpec.
-func (in *DeployTokenSpec) DeepCopy() *DeployTokenSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalSettingsSpec.
+func (in *ApprovalSettingsSpec) DeepCopy() *ApprovalSettingsSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployTokenSpec)
+	out := new(ApprovalSettingsSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployTokenStatus) DeepCopyInto(out *DeployTokenStatus) {
+func (in *ApprovalSettingsStatus) DeepCopyInto(out *ApprovalSettingsStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenStatus.
-func (in *DeployTokenStatus) DeepCopy() *DeployTokenStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalSettingsStatus.
+func (in *ApprovalSettingsStatus) DeepCopy() *ApprovalSettingsStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployTokenStatus)
+	out := new(ApprovalSettingsStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ForkParent) DeepCopyInto(out *ForkParent) {
+func (in *Branch) DeepCopyInto(out *Branch) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForkParent.
-func (in *ForkParent) DeepCopy() *ForkParent {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Branch.
+func (in *Branch) DeepCopy() *Branch {
 	if in == nil {
 		return nil
 	}
-	out := new(ForkParent)
+	out := new(Branch)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Branch) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupAccess) DeepCopyInto(out *GroupAccess) {
+func (in *BranchList) DeepCopyInto(out *BranchList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Branch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupAccess.
-func (in *GroupAccess) DeepCopy() *GroupAccess {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchList.
+func (in *BranchList) DeepCopy() *BranchList {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupAccess)
+	out := new(BranchList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BranchList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Hook) DeepCopyInto(out *Hook) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hook.
-func (in *Hook) DeepCopy() *Hook {
-	if in == nil {
-		return nil
-	}
-	out := new(Hook)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Hook) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HookList) DeepCopyInto(out *HookList) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Hook, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookList.
-func (in *HookList) DeepCopy() *HookList {
-	if in == nil {
-		return nil
-	}
-	out := new(HookList)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *HookList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HookObservation) DeepCopyInto(out *HookObservation) {
+func (in *BranchObservation) DeepCopyInto(out *BranchObservation) {
 	*out = *in
-	if in.CreatedAt != nil {
-		in, out := &in.CreatedAt, &out.CreatedAt
-		*out = (*in).DeepCopy()
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookObservation.
-func (in *HookObservation) DeepCopy() *HookObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchObservation.
+func (in *BranchObservation) DeepCopy() *BranchObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(HookObservation)
+	out := new(BranchObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HookParameters) DeepCopyInto(out *HookParameters) {
+func (in *BranchParameters) DeepCopyInto(out *BranchParameters) {
 	*out = *in
-	if in.URL != nil {
-		in, out := &in.URL, &out.URL
-		*out = new(string)
-		**out = **in
-	}
-	if in.ConfidentialNoteEvents != nil {
-		in, out := &in.ConfidentialNoteEvents, &out.ConfidentialNoteEvents
-		*out = new(bool)
-		**out = **in
-	}
 	if in.ProjectID != nil {
 		in, out := &in.ProjectID, &out.ProjectID
-		*out = new(int)
+		*out = new(string)
 		**out = **in
 	}
 	if in.ProjectIDRef != nil {
@@ -711,144 +690,173 @@ func (in *HookParameters) DeepCopyInto(out *HookParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.PushEvents != nil {
-		in, out := &in.PushEvents, &out.PushEvents
-		*out = new(bool)
-		**out = **in
-	}
-	if in.PushEventsBranchFilter != nil {
-		in, out := &in.PushEventsBranchFilter, &out.PushEventsBranchFilter
-		*out = new(string)
-		**out = **in
-	}
-	if in.IssuesEvents != nil {
-		in, out := &in.IssuesEvents, &out.IssuesEvents
-		*out = new(bool)
-		**out = **in
-	}
-	if in.ConfidentialIssuesEvents != nil {
-		in, out := &in.ConfidentialIssuesEvents, &out.ConfidentialIssuesEvents
-		*out = new(bool)
-		**out = **in
-	}
-	if in.MergeRequestsEvents != nil {
-		in, out := &in.MergeRequestsEvents, &out.MergeRequestsEvents
-		*out = new(bool)
-		**out = **in
-	}
-	if in.TagPushEvents != nil {
-		in, out := &in.TagPushEvents, &out.TagPushEvents
-		*out = new(bool)
-		**out = **in
-	}
-	if in.NoteEvents != nil {
-		in, out := &in.NoteEvents, &out.NoteEvents
-		*out = new(bool)
-		**out = **in
-	}
-	if in.JobEvents != nil {
-		in, out := &in.JobEvents, &out.JobEvents
+	if in.DeleteOnRemoval != nil {
+		in, out := &in.DeleteOnRemoval, &out.DeleteOnRemoval
 		*out = new(bool)
 		**out = **in
 	}
-	if in.PipelineEvents != nil {
-		in, out := &in.PipelineEvents, &out.PipelineEvents
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchParameters.
+func (in *BranchParameters) DeepCopy() *BranchParameters {
+	if in == nil {
+		return nil
 	}
-	if in.WikiPageEvents != nil {
-		in, out := &in.WikiPageEvents, &out.WikiPageEvents
-		*out = new(bool)
+	out := new(BranchParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchPermission) DeepCopyInto(out *BranchPermission) {
+	*out = *in
+	if in.UserID != nil {
+		in, out := &in.UserID, &out.UserID
+		*out = new(int)
 		**out = **in
 	}
-	if in.EnableSSLVerification != nil {
-		in, out := &in.EnableSSLVerification, &out.EnableSSLVerification
-		*out = new(bool)
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int)
 		**out = **in
 	}
-	if in.Token != nil {
-		in, out := &in.Token, &out.Token
-		*out = new(string)
+	if in.DeployKeyID != nil {
+		in, out := &in.DeployKeyID, &out.DeployKeyID
+		*out = new(int)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookParameters.
-func (in *HookParameters) DeepCopy() *HookParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchPermission.
+func (in *BranchPermission) DeepCopy() *BranchPermission {
 	if in == nil {
 		return nil
 	}
-	out := new(HookParameters)
+	out := new(BranchPermission)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HookSpec) DeepCopyInto(out *HookSpec) {
+func (in *BranchSpec) DeepCopyInto(out *BranchSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookSpec.
-func (in *HookSpec) DeepCopy() *HookSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchSpec.
+func (in *BranchSpec) DeepCopy() *BranchSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(HookSpec)
+	out := new(BranchSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HookStatus) DeepCopyInto(out *HookStatus) {
+func (in *BranchStatus) DeepCopyInto(out *BranchStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookStatus.
-func (in *HookStatus) DeepCopy() *HookStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchStatus.
+func (in *BranchStatus) DeepCopy() *BranchStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(HookStatus)
+	out := new(BranchStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LastPipeline) DeepCopyInto(out *LastPipeline) {
+func (in *ContainerExpirationPolicy) DeepCopyInto(out *ContainerExpirationPolicy) {
 	*out = *in
+	if in.NextRunAt != nil {
+		in, out := &in.NextRunAt, &out.NextRunAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LastPipeline.
-func (in *LastPipeline) DeepCopy() *LastPipeline {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerExpirationPolicy.
+func (in *ContainerExpirationPolicy) DeepCopy() *ContainerExpirationPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(LastPipeline)
+	out := new(ContainerExpirationPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Links) DeepCopyInto(out *Links) {
+func (in *ContainerExpirationPolicyAttributes) DeepCopyInto(out *ContainerExpirationPolicyAttributes) {
+	*out = *in
+	if in.Cadence != nil {
+		in, out := &in.Cadence, &out.Cadence
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeepN != nil {
+		in, out := &in.KeepN, &out.KeepN
+		*out = new(int)
+		**out = **in
+	}
+	if in.OlderThan != nil {
+		in, out := &in.OlderThan, &out.OlderThan
+		*out = new(string)
+		**out = **in
+	}
+	if in.NameRegexDelete != nil {
+		in, out := &in.NameRegexDelete, &out.NameRegexDelete
+		*out = new(string)
+		**out = **in
+	}
+	if in.NameRegexKeep != nil {
+		in, out := &in.NameRegexKeep, &out.NameRegexKeep
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NameRegex != nil {
+		in, out := &in.NameRegex, &out.NameRegex
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerExpirationPolicyAttributes.
+func (in *ContainerExpirationPolicyAttributes) DeepCopy() *ContainerExpirationPolicyAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerExpirationPolicyAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomAttribute) DeepCopyInto(out *CustomAttribute) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Links.
-func (in *Links) DeepCopy() *Links {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomAttribute.
+func (in *CustomAttribute) DeepCopy() *CustomAttribute {
 	if in == nil {
 		return nil
 	}
-	out := new(Links)
+	out := new(CustomAttribute)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Member) DeepCopyInto(out *Member) {
+func (in *DeployKey) DeepCopyInto(out *DeployKey) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -856,18 +864,18 @@ func (in *Member) DeepCopyInto(out *Member) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Member.
-func (in *Member) DeepCopy() *Member {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKey.
+func (in *DeployKey) DeepCopy() *DeployKey {
 	if in == nil {
 		return nil
 	}
-	out := new(Member)
+	out := new(DeployKey)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Member) DeepCopyObject() runtime.Object {
+func (in *DeployKey) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -875,31 +883,31 @@ func (in *Member) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberList) DeepCopyInto(out *MemberList) {
+func (in *DeployKeyList) DeepCopyInto(out *DeployKeyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Member, len(*in))
+		*out = make([]DeployKey, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberList.
-func (in *MemberList) DeepCopy() *MemberList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyList.
+func (in *DeployKeyList) DeepCopy() *DeployKeyList {
 	if in == nil {
 		return nil
 	}
-	out := new(MemberList)
+	out := new(DeployKeyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MemberList) DeepCopyObject() runtime.Object {
+func (in *DeployKeyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -907,30 +915,35 @@ func (in *MemberList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberObservation) DeepCopyInto(out *MemberObservation) {
+func (in *DeployKeyObservation) DeepCopyInto(out *DeployKeyObservation) {
 	*out = *in
-	if in.CreatedAt != nil {
-		in, out := &in.CreatedAt, &out.CreatedAt
-		*out = (*in).DeepCopy()
-	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int)
+		**out = **in
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberObservation.
-func (in *MemberObservation) DeepCopy() *MemberObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyObservation.
+func (in *DeployKeyObservation) DeepCopy() *DeployKeyObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(MemberObservation)
+	out := new(DeployKeyObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberParameters) DeepCopyInto(out *MemberParameters) {
+func (in *DeployKeyParameters) DeepCopyInto(out *DeployKeyParameters) {
 	*out = *in
 	if in.ProjectID != nil {
 		in, out := &in.ProjectID, &out.ProjectID
-		*out = new(int)
+		*out = new(string)
 		**out = **in
 	}
 	if in.ProjectIDRef != nil {
@@ -943,94 +956,64 @@ func (in *MemberParameters) DeepCopyInto(out *MemberParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.UserID != nil {
-		in, out := &in.UserID, &out.UserID
-		*out = new(int)
-		**out = **in
-	}
-	if in.UserName != nil {
-		in, out := &in.UserName, &out.UserName
-		*out = new(string)
+	if in.CanPush != nil {
+		in, out := &in.CanPush, &out.CanPush
+		*out = new(bool)
 		**out = **in
 	}
 	if in.ExpiresAt != nil {
 		in, out := &in.ExpiresAt, &out.ExpiresAt
-		*out = new(string)
-		**out = **in
+		*out = (*in).DeepCopy()
 	}
+	out.KeySecretRef = in.KeySecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberParameters.
-func (in *MemberParameters) DeepCopy() *MemberParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyParameters.
+func (in *DeployKeyParameters) DeepCopy() *DeployKeyParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(MemberParameters)
+	out := new(DeployKeyParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberSpec) DeepCopyInto(out *MemberSpec) {
+func (in *DeployKeySpec) DeepCopyInto(out *DeployKeySpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberSpec.
-func (in *MemberSpec) DeepCopy() *MemberSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeySpec.
+func (in *DeployKeySpec) DeepCopy() *DeployKeySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MemberSpec)
+	out := new(DeployKeySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemberStatus) DeepCopyInto(out *MemberStatus) {
+func (in *DeployKeyStatus) DeepCopyInto(out *DeployKeyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberStatus.
-func (in *MemberStatus) DeepCopy() *MemberStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(MemberStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Permissions) DeepCopyInto(out *Permissions) {
-	*out = *in
-	if in.ProjectAccess != nil {
-		in, out := &in.ProjectAccess, &out.ProjectAccess
-		*out = new(ProjectAccess)
-		**out = **in
-	}
-	if in.GroupAccess != nil {
-		in, out := &in.GroupAccess, &out.GroupAccess
-		*out = new(GroupAccess)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Permissions.
-func (in *Permissions) DeepCopy() *Permissions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployKeyStatus.
+func (in *DeployKeyStatus) DeepCopy() *DeployKeyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Permissions)
+	out := new(DeployKeyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineSchedule) DeepCopyInto(out *PipelineSchedule) {
+func (in *DeployToken) DeepCopyInto(out *DeployToken) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1038,18 +1021,18 @@ func (in *PipelineSchedule) DeepCopyInto(out *PipelineSchedule) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineSchedule.
-func (in *PipelineSchedule) DeepCopy() *PipelineSchedule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployToken.
+func (in *DeployToken) DeepCopy() *DeployToken {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineSchedule)
+	out := new(DeployToken)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PipelineSchedule) DeepCopyObject() runtime.Object {
+func (in *DeployToken) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1057,31 +1040,31 @@ func (in *PipelineSchedule) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineScheduleList) DeepCopyInto(out *PipelineScheduleList) {
+func (in *DeployTokenList) DeepCopyInto(out *DeployTokenList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]PipelineSchedule, len(*in))
+		*out = make([]DeployToken, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleList.
-func (in *PipelineScheduleList) DeepCopy() *PipelineScheduleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenList.
+func (in *DeployTokenList) DeepCopy() *DeployTokenList {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineScheduleList)
+	out := new(DeployTokenList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PipelineScheduleList) DeepCopyObject() runtime.Object {
+func (in *DeployTokenList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1089,53 +1072,26 @@ func (in *PipelineScheduleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineScheduleObservation) DeepCopyInto(out *PipelineScheduleObservation) {
+func (in *DeployTokenObservation) DeepCopyInto(out *DeployTokenObservation) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(int)
-		**out = **in
-	}
-	if in.NextRunAt != nil {
-		in, out := &in.NextRunAt, &out.NextRunAt
-		*out = (*in).DeepCopy()
-	}
-	if in.CreatedAt != nil {
-		in, out := &in.CreatedAt, &out.CreatedAt
-		*out = (*in).DeepCopy()
-	}
-	if in.UpdatedAt != nil {
-		in, out := &in.UpdatedAt, &out.UpdatedAt
-		*out = (*in).DeepCopy()
-	}
-	if in.Owner != nil {
-		in, out := &in.Owner, &out.Owner
-		*out = new(User)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.LastPipeline != nil {
-		in, out := &in.LastPipeline, &out.LastPipeline
-		*out = new(LastPipeline)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleObservation.
-func (in *PipelineScheduleObservation) DeepCopy() *PipelineScheduleObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenObservation.
+func (in *DeployTokenObservation) DeepCopy() *DeployTokenObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineScheduleObservation)
+	out := new(DeployTokenObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineScheduleParameters) DeepCopyInto(out *PipelineScheduleParameters) {
+func (in *DeployTokenParameters) DeepCopyInto(out *DeployTokenParameters) {
 	*out = *in
 	if in.ProjectID != nil {
 		in, out := &in.ProjectID, &out.ProjectID
-		*out = new(string)
+		*out = new(int)
 		**out = **in
 	}
 	if in.ProjectIDRef != nil {
@@ -1148,91 +1104,98 @@ func (in *PipelineScheduleParameters) DeepCopyInto(out *PipelineScheduleParamete
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.CronTimezone != nil {
-		in, out := &in.CronTimezone, &out.CronTimezone
-		*out = new(string)
-		**out = **in
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
 	}
-	if in.Active != nil {
-		in, out := &in.Active, &out.Active
-		*out = new(bool)
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
 		**out = **in
 	}
-	if in.Variables != nil {
-		in, out := &in.Variables, &out.Variables
-		*out = make([]PipelineVariable, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleParameters.
-func (in *PipelineScheduleParameters) DeepCopy() *PipelineScheduleParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenParameters.
+func (in *DeployTokenParameters) DeepCopy() *DeployTokenParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineScheduleParameters)
+	out := new(DeployTokenParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineScheduleSpec) DeepCopyInto(out *PipelineScheduleSpec) {
+func (in *DeployTokenSpec) DeepCopyInto(out *DeployTokenSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleSpec.
-func (in *PipelineScheduleSpec) DeepCopy() *PipelineScheduleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenSpec.
+func (in *DeployTokenSpec) DeepCopy() *DeployTokenSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineScheduleSpec)
+	out := new(DeployTokenSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineScheduleStatus) DeepCopyInto(out *PipelineScheduleStatus) {
+func (in *DeployTokenStatus) DeepCopyInto(out *DeployTokenStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleStatus.
-func (in *PipelineScheduleStatus) DeepCopy() *PipelineScheduleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployTokenStatus.
+func (in *DeployTokenStatus) DeepCopy() *DeployTokenStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineScheduleStatus)
+	out := new(DeployTokenStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineVariable) DeepCopyInto(out *PipelineVariable) {
+func (in *ForkParent) DeepCopyInto(out *ForkParent) {
 	*out = *in
-	if in.VariableType != nil {
-		in, out := &in.VariableType, &out.VariableType
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForkParent.
+func (in *ForkParent) DeepCopy() *ForkParent {
+	if in == nil {
+		return nil
 	}
+	out := new(ForkParent)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineVariable.
-func (in *PipelineVariable) DeepCopy() *PipelineVariable {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupAccess) DeepCopyInto(out *GroupAccess) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupAccess.
+func (in *GroupAccess) DeepCopy() *GroupAccess {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineVariable)
+	out := new(GroupAccess)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Project) DeepCopyInto(out *Project) {
+func (in *Hook) DeepCopyInto(out *Hook) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1240,18 +1203,18 @@ func (in *Project) DeepCopyInto(out *Project) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Project.
-func (in *Project) DeepCopy() *Project {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hook.
+func (in *Hook) DeepCopy() *Hook {
 	if in == nil {
 		return nil
 	}
-	out := new(Project)
+	out := new(Hook)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Project) DeepCopyObject() runtime.Object {
+func (in *Hook) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1259,540 +1222,1773 @@ func (in *Project) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectAccess) DeepCopyInto(out *ProjectAccess) {
+func (in *HookList) DeepCopyInto(out *HookList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Hook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAccess.
-func (in *ProjectAccess) DeepCopy() *ProjectAccess {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookList.
+func (in *HookList) DeepCopy() *HookList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectAccess)
+	out := new(HookList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HookList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectLicense) DeepCopyInto(out *ProjectLicense) {
+func (in *HookObservation) DeepCopyInto(out *HookObservation) {
 	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectLicense.
-func (in *ProjectLicense) DeepCopy() *ProjectLicense {
-	if in == nil {
-		return nil
-	}
-	out := new(ProjectLicense)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectList) DeepCopyInto(out *ProjectList) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Project, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectList.
-func (in *ProjectList) DeepCopy() *ProjectList {
-	if in == nil {
-		return nil
-	}
-	out := new(ProjectList)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProjectList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectNamespace) DeepCopyInto(out *ProjectNamespace) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectNamespace.
-func (in *ProjectNamespace) DeepCopy() *ProjectNamespace {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookObservation.
+func (in *HookObservation) DeepCopy() *HookObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectNamespace)
+	out := new(HookObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectObservation) DeepCopyInto(out *ProjectObservation) {
+func (in *HookParameters) DeepCopyInto(out *HookParameters) {
 	*out = *in
-	if in.ComplianceFrameworks != nil {
-		in, out := &in.ComplianceFrameworks, &out.ComplianceFrameworks
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.ContainerExpirationPolicy != nil {
-		in, out := &in.ContainerExpirationPolicy, &out.ContainerExpirationPolicy
-		*out = new(ContainerExpirationPolicy)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.CreatedAt != nil {
-		in, out := &in.CreatedAt, &out.CreatedAt
-		*out = (*in).DeepCopy()
-	}
-	if in.CustomAttributes != nil {
-		in, out := &in.CustomAttributes, &out.CustomAttributes
-		*out = make([]CustomAttribute, len(*in))
-		copy(*out, *in)
-	}
-	if in.ForkedFromProject != nil {
-		in, out := &in.ForkedFromProject, &out.ForkedFromProject
-		*out = new(ForkParent)
-		**out = **in
-	}
-	if in.LastActivityAt != nil {
-		in, out := &in.LastActivityAt, &out.LastActivityAt
-		*out = (*in).DeepCopy()
-	}
-	if in.License != nil {
-		in, out := &in.License, &out.License
-		*out = new(ProjectLicense)
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
 		**out = **in
 	}
-	if in.Links != nil {
-		in, out := &in.Links, &out.Links
-		*out = new(Links)
+	if in.ConfidentialNoteEvents != nil {
+		in, out := &in.ConfidentialNoteEvents, &out.ConfidentialNoteEvents
+		*out = new(bool)
 		**out = **in
 	}
-	if in.MarkedForDeletionAt != nil {
-		in, out := &in.MarkedForDeletionAt, &out.MarkedForDeletionAt
-		*out = (*in).DeepCopy()
-	}
-	if in.Namespace != nil {
-		in, out := &in.Namespace, &out.Namespace
-		*out = new(ProjectNamespace)
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(int)
 		**out = **in
 	}
-	if in.Owner != nil {
-		in, out := &in.Owner, &out.Owner
-		*out = new(User)
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Permissions != nil {
-		in, out := &in.Permissions, &out.Permissions
-		*out = new(Permissions)
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.SharedWithGroups != nil {
-		in, out := &in.SharedWithGroups, &out.SharedWithGroups
-		*out = make([]SharedWithGroups, len(*in))
-		copy(*out, *in)
-	}
-	if in.Statistics != nil {
-		in, out := &in.Statistics, &out.Statistics
-		*out = new(ProjectStatistics)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectObservation.
-func (in *ProjectObservation) DeepCopy() *ProjectObservation {
-	if in == nil {
-		return nil
-	}
-	out := new(ProjectObservation)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectParameters) DeepCopyInto(out *ProjectParameters) {
-	*out = *in
-	if in.AllowMergeOnSkippedPipeline != nil {
-		in, out := &in.AllowMergeOnSkippedPipeline, &out.AllowMergeOnSkippedPipeline
+	if in.PushEvents != nil {
+		in, out := &in.PushEvents, &out.PushEvents
 		*out = new(bool)
 		**out = **in
 	}
-	if in.ApprovalsBeforeMerge != nil {
-		in, out := &in.ApprovalsBeforeMerge, &out.ApprovalsBeforeMerge
-		*out = new(int)
-		**out = **in
-	}
-	if in.AutoCancelPendingPipelines != nil {
-		in, out := &in.AutoCancelPendingPipelines, &out.AutoCancelPendingPipelines
-		*out = new(string)
-		**out = **in
-	}
-	if in.AutoDevopsDeployStrategy != nil {
-		in, out := &in.AutoDevopsDeployStrategy, &out.AutoDevopsDeployStrategy
+	if in.PushEventsBranchFilter != nil {
+		in, out := &in.PushEventsBranchFilter, &out.PushEventsBranchFilter
 		*out = new(string)
 		**out = **in
 	}
-	if in.AutoDevopsEnabled != nil {
-		in, out := &in.AutoDevopsEnabled, &out.AutoDevopsEnabled
+	if in.IssuesEvents != nil {
+		in, out := &in.IssuesEvents, &out.IssuesEvents
 		*out = new(bool)
 		**out = **in
 	}
-	if in.AutocloseReferencedIssues != nil {
-		in, out := &in.AutocloseReferencedIssues, &out.AutocloseReferencedIssues
+	if in.ConfidentialIssuesEvents != nil {
+		in, out := &in.ConfidentialIssuesEvents, &out.ConfidentialIssuesEvents
 		*out = new(bool)
 		**out = **in
 	}
-	if in.BuildCoverageRegex != nil {
-		in, out := &in.BuildCoverageRegex, &out.BuildCoverageRegex
-		*out = new(string)
-		**out = **in
-	}
-	if in.BuildGitStrategy != nil {
-		in, out := &in.BuildGitStrategy, &out.BuildGitStrategy
-		*out = new(string)
-		**out = **in
-	}
-	if in.BuildTimeout != nil {
-		in, out := &in.BuildTimeout, &out.BuildTimeout
-		*out = new(int)
-		**out = **in
-	}
-	if in.BuildsAccessLevel != nil {
-		in, out := &in.BuildsAccessLevel, &out.BuildsAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
-	}
-	if in.CIConfigPath != nil {
-		in, out := &in.CIConfigPath, &out.CIConfigPath
-		*out = new(string)
-		**out = **in
-	}
-	if in.CIDefaultGitDepth != nil {
-		in, out := &in.CIDefaultGitDepth, &out.CIDefaultGitDepth
-		*out = new(int)
+	if in.MergeRequestsEvents != nil {
+		in, out := &in.MergeRequestsEvents, &out.MergeRequestsEvents
+		*out = new(bool)
 		**out = **in
 	}
-	if in.CIForwardDeploymentEnabled != nil {
-		in, out := &in.CIForwardDeploymentEnabled, &out.CIForwardDeploymentEnabled
+	if in.TagPushEvents != nil {
+		in, out := &in.TagPushEvents, &out.TagPushEvents
 		*out = new(bool)
 		**out = **in
 	}
-	if in.ContainerExpirationPolicyAttributes != nil {
-		in, out := &in.ContainerExpirationPolicyAttributes, &out.ContainerExpirationPolicyAttributes
-		*out = new(ContainerExpirationPolicyAttributes)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ContainerRegistryEnabled != nil {
-		in, out := &in.ContainerRegistryEnabled, &out.ContainerRegistryEnabled
+	if in.NoteEvents != nil {
+		in, out := &in.NoteEvents, &out.NoteEvents
 		*out = new(bool)
 		**out = **in
 	}
-	if in.DefaultBranch != nil {
-		in, out := &in.DefaultBranch, &out.DefaultBranch
-		*out = new(string)
+	if in.JobEvents != nil {
+		in, out := &in.JobEvents, &out.JobEvents
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
+	if in.PipelineEvents != nil {
+		in, out := &in.PipelineEvents, &out.PipelineEvents
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
+	if in.WikiPageEvents != nil {
+		in, out := &in.WikiPageEvents, &out.WikiPageEvents
+		*out = new(bool)
 		**out = **in
 	}
-	if in.EmailsDisabled != nil {
-		in, out := &in.EmailsDisabled, &out.EmailsDisabled
+	if in.EnableSSLVerification != nil {
+		in, out := &in.EnableSSLVerification, &out.EnableSSLVerification
 		*out = new(bool)
 		**out = **in
 	}
-	if in.ExternalAuthorizationClassificationLabel != nil {
-		in, out := &in.ExternalAuthorizationClassificationLabel, &out.ExternalAuthorizationClassificationLabel
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
 		*out = new(string)
 		**out = **in
 	}
-	if in.ForkingAccessLevel != nil {
-		in, out := &in.ForkingAccessLevel, &out.ForkingAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
-	}
-	if in.GroupWithProjectTemplatesID != nil {
-		in, out := &in.GroupWithProjectTemplatesID, &out.GroupWithProjectTemplatesID
-		*out = new(int)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookParameters.
+func (in *HookParameters) DeepCopy() *HookParameters {
+	if in == nil {
+		return nil
 	}
-	if in.ImportURL != nil {
-		in, out := &in.ImportURL, &out.ImportURL
-		*out = new(string)
+	out := new(HookParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HookSpec) DeepCopyInto(out *HookSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookSpec.
+func (in *HookSpec) DeepCopy() *HookSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HookSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HookStatus) DeepCopyInto(out *HookStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookStatus.
+func (in *HookStatus) DeepCopy() *HookStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HookStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LastPipeline) DeepCopyInto(out *LastPipeline) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LastPipeline.
+func (in *LastPipeline) DeepCopy() *LastPipeline {
+	if in == nil {
+		return nil
+	}
+	out := new(LastPipeline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Links) DeepCopyInto(out *Links) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Links.
+func (in *Links) DeepCopy() *Links {
+	if in == nil {
+		return nil
+	}
+	out := new(Links)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Member) DeepCopyInto(out *Member) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Member.
+func (in *Member) DeepCopy() *Member {
+	if in == nil {
+		return nil
+	}
+	out := new(Member)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Member) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberList) DeepCopyInto(out *MemberList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Member, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberList.
+func (in *MemberList) DeepCopy() *MemberList {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MemberList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberObservation) DeepCopyInto(out *MemberObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberObservation.
+func (in *MemberObservation) DeepCopy() *MemberObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberParameters) DeepCopyInto(out *MemberParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(int)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserID != nil {
+		in, out := &in.UserID, &out.UserID
+		*out = new(int)
+		**out = **in
+	}
+	if in.UserName != nil {
+		in, out := &in.UserName, &out.UserName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberParameters.
+func (in *MemberParameters) DeepCopy() *MemberParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberSpec) DeepCopyInto(out *MemberSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberSpec.
+func (in *MemberSpec) DeepCopy() *MemberSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberStatus) DeepCopyInto(out *MemberStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberStatus.
+func (in *MemberStatus) DeepCopy() *MemberStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Permissions) DeepCopyInto(out *Permissions) {
+	*out = *in
+	if in.ProjectAccess != nil {
+		in, out := &in.ProjectAccess, &out.ProjectAccess
+		*out = new(ProjectAccess)
+		**out = **in
+	}
+	if in.GroupAccess != nil {
+		in, out := &in.GroupAccess, &out.GroupAccess
+		*out = new(GroupAccess)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Permissions.
+func (in *Permissions) DeepCopy() *Permissions {
+	if in == nil {
+		return nil
+	}
+	out := new(Permissions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineSchedule) DeepCopyInto(out *PipelineSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineSchedule.
+func (in *PipelineSchedule) DeepCopy() *PipelineSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PipelineSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineScheduleList) DeepCopyInto(out *PipelineScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PipelineSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleList.
+func (in *PipelineScheduleList) DeepCopy() *PipelineScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PipelineScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineScheduleObservation) DeepCopyInto(out *PipelineScheduleObservation) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int)
+		**out = **in
+	}
+	if in.NextRunAt != nil {
+		in, out := &in.NextRunAt, &out.NextRunAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Owner != nil {
+		in, out := &in.Owner, &out.Owner
+		*out = new(User)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastPipeline != nil {
+		in, out := &in.LastPipeline, &out.LastPipeline
+		*out = new(LastPipeline)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleObservation.
+func (in *PipelineScheduleObservation) DeepCopy() *PipelineScheduleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineScheduleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineScheduleParameters) DeepCopyInto(out *PipelineScheduleParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CronTimezone != nil {
+		in, out := &in.CronTimezone, &out.CronTimezone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make([]PipelineVariable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleParameters.
+func (in *PipelineScheduleParameters) DeepCopy() *PipelineScheduleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineScheduleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineScheduleSpec) DeepCopyInto(out *PipelineScheduleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleSpec.
+func (in *PipelineScheduleSpec) DeepCopy() *PipelineScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineScheduleStatus) DeepCopyInto(out *PipelineScheduleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineScheduleStatus.
+func (in *PipelineScheduleStatus) DeepCopy() *PipelineScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineVariable) DeepCopyInto(out *PipelineVariable) {
+	*out = *in
+	if in.VariableType != nil {
+		in, out := &in.VariableType, &out.VariableType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineVariable.
+func (in *PipelineVariable) DeepCopy() *PipelineVariable {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineVariable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Project) DeepCopyInto(out *Project) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Project.
+func (in *Project) DeepCopy() *Project {
+	if in == nil {
+		return nil
+	}
+	out := new(Project)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Project) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAccess) DeepCopyInto(out *ProjectAccess) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAccess.
+func (in *ProjectAccess) DeepCopy() *ProjectAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectLicense) DeepCopyInto(out *ProjectLicense) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectLicense.
+func (in *ProjectLicense) DeepCopy() *ProjectLicense {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectLicense)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectList) DeepCopyInto(out *ProjectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Project, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectList.
+func (in *ProjectList) DeepCopy() *ProjectList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectNamespace) DeepCopyInto(out *ProjectNamespace) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectNamespace.
+func (in *ProjectNamespace) DeepCopy() *ProjectNamespace {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectNamespace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectObservation) DeepCopyInto(out *ProjectObservation) {
+	*out = *in
+	if in.ComplianceFrameworks != nil {
+		in, out := &in.ComplianceFrameworks, &out.ComplianceFrameworks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ContainerExpirationPolicy != nil {
+		in, out := &in.ContainerExpirationPolicy, &out.ContainerExpirationPolicy
+		*out = new(ContainerExpirationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CustomAttributes != nil {
+		in, out := &in.CustomAttributes, &out.CustomAttributes
+		*out = make([]CustomAttribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.ForkedFromProject != nil {
+		in, out := &in.ForkedFromProject, &out.ForkedFromProject
+		*out = new(ForkParent)
+		**out = **in
+	}
+	if in.LastActivityAt != nil {
+		in, out := &in.LastActivityAt, &out.LastActivityAt
+		*out = (*in).DeepCopy()
+	}
+	if in.License != nil {
+		in, out := &in.License, &out.License
+		*out = new(ProjectLicense)
+		**out = **in
+	}
+	if in.Links != nil {
+		in, out := &in.Links, &out.Links
+		*out = new(Links)
+		**out = **in
+	}
+	if in.MarkedForDeletionAt != nil {
+		in, out := &in.MarkedForDeletionAt, &out.MarkedForDeletionAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(ProjectNamespace)
+		**out = **in
+	}
+	if in.Owner != nil {
+		in, out := &in.Owner, &out.Owner
+		*out = new(User)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = new(Permissions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SharedWithGroups != nil {
+		in, out := &in.SharedWithGroups, &out.SharedWithGroups
+		*out = make([]SharedWithGroups, len(*in))
+		copy(*out, *in)
+	}
+	if in.Statistics != nil {
+		in, out := &in.Statistics, &out.Statistics
+		*out = new(ProjectStatistics)
+		**out = **in
+	}
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(common.LastError)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectObservation.
+func (in *ProjectObservation) DeepCopy() *ProjectObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectParameters) DeepCopyInto(out *ProjectParameters) {
+	*out = *in
+	if in.AllowMergeOnSkippedPipeline != nil {
+		in, out := &in.AllowMergeOnSkippedPipeline, &out.AllowMergeOnSkippedPipeline
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ApprovalsBeforeMerge != nil {
+		in, out := &in.ApprovalsBeforeMerge, &out.ApprovalsBeforeMerge
+		*out = new(int)
+		**out = **in
+	}
+	if in.Archived != nil {
+		in, out := &in.Archived, &out.Archived
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutoCancelPendingPipelines != nil {
+		in, out := &in.AutoCancelPendingPipelines, &out.AutoCancelPendingPipelines
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoDevopsDeployStrategy != nil {
+		in, out := &in.AutoDevopsDeployStrategy, &out.AutoDevopsDeployStrategy
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoDevopsEnabled != nil {
+		in, out := &in.AutoDevopsEnabled, &out.AutoDevopsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutocloseReferencedIssues != nil {
+		in, out := &in.AutocloseReferencedIssues, &out.AutocloseReferencedIssues
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BuildCoverageRegex != nil {
+		in, out := &in.BuildCoverageRegex, &out.BuildCoverageRegex
+		*out = new(string)
+		**out = **in
+	}
+	if in.BuildGitStrategy != nil {
+		in, out := &in.BuildGitStrategy, &out.BuildGitStrategy
+		*out = new(string)
+		**out = **in
+	}
+	if in.BuildTimeout != nil {
+		in, out := &in.BuildTimeout, &out.BuildTimeout
+		*out = new(int)
+		**out = **in
+	}
+	if in.BuildsAccessLevel != nil {
+		in, out := &in.BuildsAccessLevel, &out.BuildsAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.CIConfigPath != nil {
+		in, out := &in.CIConfigPath, &out.CIConfigPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.CIDefaultGitDepth != nil {
+		in, out := &in.CIDefaultGitDepth, &out.CIDefaultGitDepth
+		*out = new(int)
+		**out = **in
+	}
+	if in.CIForwardDeploymentEnabled != nil {
+		in, out := &in.CIForwardDeploymentEnabled, &out.CIForwardDeploymentEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ContainerExpirationPolicyAttributes != nil {
+		in, out := &in.ContainerExpirationPolicyAttributes, &out.ContainerExpirationPolicyAttributes
+		*out = new(ContainerExpirationPolicyAttributes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContainerRegistryEnabled != nil {
+		in, out := &in.ContainerRegistryEnabled, &out.ContainerRegistryEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DefaultBranch != nil {
+		in, out := &in.DefaultBranch, &out.DefaultBranch
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeletionMode != nil {
+		in, out := &in.DeletionMode, &out.DeletionMode
+		*out = new(DeletionMode)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.EmailsDisabled != nil {
+		in, out := &in.EmailsDisabled, &out.EmailsDisabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExternalAuthorizationClassificationLabel != nil {
+		in, out := &in.ExternalAuthorizationClassificationLabel, &out.ExternalAuthorizationClassificationLabel
+		*out = new(string)
+		**out = **in
+	}
+	if in.ForkingAccessLevel != nil {
+		in, out := &in.ForkingAccessLevel, &out.ForkingAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.GroupWithProjectTemplatesID != nil {
+		in, out := &in.GroupWithProjectTemplatesID, &out.GroupWithProjectTemplatesID
+		*out = new(int)
+		**out = **in
+	}
+	if in.ImportURL != nil {
+		in, out := &in.ImportURL, &out.ImportURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.InitializeWithReadme != nil {
+		in, out := &in.InitializeWithReadme, &out.InitializeWithReadme
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IssuesAccessLevel != nil {
+		in, out := &in.IssuesAccessLevel, &out.IssuesAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.IssuesTemplate != nil {
+		in, out := &in.IssuesTemplate, &out.IssuesTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.LFSEnabled != nil {
+		in, out := &in.LFSEnabled, &out.LFSEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MergeMethod != nil {
+		in, out := &in.MergeMethod, &out.MergeMethod
+		*out = new(MergeMethodValue)
+		**out = **in
+	}
+	if in.MergeRequestsAccessLevel != nil {
+		in, out := &in.MergeRequestsAccessLevel, &out.MergeRequestsAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.MergeRequestsTemplate != nil {
+		in, out := &in.MergeRequestsTemplate, &out.MergeRequestsTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.Mirror != nil {
+		in, out := &in.Mirror, &out.Mirror
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MirrorOverwritesDivergedBranches != nil {
+		in, out := &in.MirrorOverwritesDivergedBranches, &out.MirrorOverwritesDivergedBranches
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MirrorTriggerBuilds != nil {
+		in, out := &in.MirrorTriggerBuilds, &out.MirrorTriggerBuilds
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MirrorUserID != nil {
+		in, out := &in.MirrorUserID, &out.MirrorUserID
+		*out = new(int)
+		**out = **in
+	}
+	if in.NamespaceID != nil {
+		in, out := &in.NamespaceID, &out.NamespaceID
+		*out = new(int)
+		**out = **in
+	}
+	if in.NamespaceIDRef != nil {
+		in, out := &in.NamespaceIDRef, &out.NamespaceIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceIDSelector != nil {
+		in, out := &in.NamespaceIDSelector, &out.NamespaceIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OnlyAllowMergeIfAllDiscussionsAreResolved != nil {
+		in, out := &in.OnlyAllowMergeIfAllDiscussionsAreResolved, &out.OnlyAllowMergeIfAllDiscussionsAreResolved
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnlyAllowMergeIfPipelineSucceeds != nil {
+		in, out := &in.OnlyAllowMergeIfPipelineSucceeds, &out.OnlyAllowMergeIfPipelineSucceeds
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnlyMirrorProtectedBranches != nil {
+		in, out := &in.OnlyMirrorProtectedBranches, &out.OnlyMirrorProtectedBranches
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OperationsAccessLevel != nil {
+		in, out := &in.OperationsAccessLevel, &out.OperationsAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.PackagesEnabled != nil {
+		in, out := &in.PackagesEnabled, &out.PackagesEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PagesAccessLevel != nil {
+		in, out := &in.PagesAccessLevel, &out.PagesAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrintingMergeRequestLinkEnabled != nil {
+		in, out := &in.PrintingMergeRequestLinkEnabled, &out.PrintingMergeRequestLinkEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PublicBuilds != nil {
+		in, out := &in.PublicBuilds, &out.PublicBuilds
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RemoveSourceBranchAfterMerge != nil {
+		in, out := &in.RemoveSourceBranchAfterMerge, &out.RemoveSourceBranchAfterMerge
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RepositoryAccessLevel != nil {
+		in, out := &in.RepositoryAccessLevel, &out.RepositoryAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.RequestAccessEnabled != nil {
+		in, out := &in.RequestAccessEnabled, &out.RequestAccessEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResolveOutdatedDiffDiscussions != nil {
+		in, out := &in.ResolveOutdatedDiffDiscussions, &out.ResolveOutdatedDiffDiscussions
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ServiceDeskEnabled != nil {
+		in, out := &in.ServiceDeskEnabled, &out.ServiceDeskEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SharedRunnersEnabled != nil {
+		in, out := &in.SharedRunnersEnabled, &out.SharedRunnersEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SharedWithGroups != nil {
+		in, out := &in.SharedWithGroups, &out.SharedWithGroups
+		*out = make([]SharedWithGroupsParameters, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SnippetsAccessLevel != nil {
+		in, out := &in.SnippetsAccessLevel, &out.SnippetsAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+	if in.SuggestionCommitMessage != nil {
+		in, out := &in.SuggestionCommitMessage, &out.SuggestionCommitMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.TagList != nil {
+		in, out := &in.TagList, &out.TagList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TemplateName != nil {
+		in, out := &in.TemplateName, &out.TemplateName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TemplateProjectID != nil {
+		in, out := &in.TemplateProjectID, &out.TemplateProjectID
+		*out = new(int)
+		**out = **in
+	}
+	if in.UseCustomTemplate != nil {
+		in, out := &in.UseCustomTemplate, &out.UseCustomTemplate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Visibility != nil {
+		in, out := &in.Visibility, &out.Visibility
+		*out = new(VisibilityValue)
+		**out = **in
+	}
+	if in.WikiAccessLevel != nil {
+		in, out := &in.WikiAccessLevel, &out.WikiAccessLevel
+		*out = new(AccessControlValue)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectParameters.
+func (in *ProjectParameters) DeepCopy() *ProjectParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
+func (in *ProjectSpec) DeepCopy() *ProjectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectStatistics) DeepCopyInto(out *ProjectStatistics) {
+	*out = *in
+	out.StorageStatistics = in.StorageStatistics
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatistics.
+func (in *ProjectStatistics) DeepCopy() *ProjectStatistics {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectStatistics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
+func (in *ProjectStatus) DeepCopy() *ProjectStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedBranch) DeepCopyInto(out *ProtectedBranch) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedBranch.
+func (in *ProtectedBranch) DeepCopy() *ProtectedBranch {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedBranch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProtectedBranch) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedBranchList) DeepCopyInto(out *ProtectedBranchList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProtectedBranch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedBranchList.
+func (in *ProtectedBranchList) DeepCopy() *ProtectedBranchList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedBranchList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProtectedBranchList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedBranchObservation) DeepCopyInto(out *ProtectedBranchObservation) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int)
 		**out = **in
 	}
-	if in.InitializeWithReadme != nil {
-		in, out := &in.InitializeWithReadme, &out.InitializeWithReadme
-		*out = new(bool)
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(common.LastError)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedBranchObservation.
+func (in *ProtectedBranchObservation) DeepCopy() *ProtectedBranchObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedBranchObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedBranchParameters) DeepCopyInto(out *ProtectedBranchParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
 		**out = **in
 	}
-	if in.IssuesAccessLevel != nil {
-		in, out := &in.IssuesAccessLevel, &out.IssuesAccessLevel
-		*out = new(AccessControlValue)
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PushAccessLevel != nil {
+		in, out := &in.PushAccessLevel, &out.PushAccessLevel
+		*out = new(AccessLevelValue)
 		**out = **in
 	}
-	if in.IssuesTemplate != nil {
-		in, out := &in.IssuesTemplate, &out.IssuesTemplate
-		*out = new(string)
+	if in.MergeAccessLevel != nil {
+		in, out := &in.MergeAccessLevel, &out.MergeAccessLevel
+		*out = new(AccessLevelValue)
 		**out = **in
 	}
-	if in.LFSEnabled != nil {
-		in, out := &in.LFSEnabled, &out.LFSEnabled
-		*out = new(bool)
+	if in.UnprotectAccessLevel != nil {
+		in, out := &in.UnprotectAccessLevel, &out.UnprotectAccessLevel
+		*out = new(AccessLevelValue)
 		**out = **in
 	}
-	if in.MergeMethod != nil {
-		in, out := &in.MergeMethod, &out.MergeMethod
-		*out = new(MergeMethodValue)
+	if in.AllowedToPush != nil {
+		in, out := &in.AllowedToPush, &out.AllowedToPush
+		*out = make([]BranchPermission, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowedToMerge != nil {
+		in, out := &in.AllowedToMerge, &out.AllowedToMerge
+		*out = make([]BranchPermission, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowedToUnprotect != nil {
+		in, out := &in.AllowedToUnprotect, &out.AllowedToUnprotect
+		*out = make([]BranchPermission, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowForcePush != nil {
+		in, out := &in.AllowForcePush, &out.AllowForcePush
+		*out = new(bool)
 		**out = **in
 	}
-	if in.MergeRequestsAccessLevel != nil {
-		in, out := &in.MergeRequestsAccessLevel, &out.MergeRequestsAccessLevel
-		*out = new(AccessControlValue)
+	if in.CodeOwnerApprovalRequired != nil {
+		in, out := &in.CodeOwnerApprovalRequired, &out.CodeOwnerApprovalRequired
+		*out = new(bool)
 		**out = **in
 	}
-	if in.MergeRequestsTemplate != nil {
-		in, out := &in.MergeRequestsTemplate, &out.MergeRequestsTemplate
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedBranchParameters.
+func (in *ProtectedBranchParameters) DeepCopy() *ProtectedBranchParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedBranchParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedBranchSpec) DeepCopyInto(out *ProtectedBranchSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedBranchSpec.
+func (in *ProtectedBranchSpec) DeepCopy() *ProtectedBranchSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedBranchSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedBranchStatus) DeepCopyInto(out *ProtectedBranchStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedBranchStatus.
+func (in *ProtectedBranchStatus) DeepCopy() *ProtectedBranchStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedBranchStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedTag) DeepCopyInto(out *ProtectedTag) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedTag.
+func (in *ProtectedTag) DeepCopy() *ProtectedTag {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedTag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProtectedTag) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedTagList) DeepCopyInto(out *ProtectedTagList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProtectedTag, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedTagList.
+func (in *ProtectedTagList) DeepCopy() *ProtectedTagList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedTagList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProtectedTagList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedTagObservation) DeepCopyInto(out *ProtectedTagObservation) {
+	*out = *in
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(common.LastError)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedTagObservation.
+func (in *ProtectedTagObservation) DeepCopy() *ProtectedTagObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedTagObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedTagParameters) DeepCopyInto(out *ProtectedTagParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
 		*out = new(string)
 		**out = **in
 	}
-	if in.Mirror != nil {
-		in, out := &in.Mirror, &out.Mirror
-		*out = new(bool)
-		**out = **in
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CreateAccessLevels != nil {
+		in, out := &in.CreateAccessLevels, &out.CreateAccessLevels
+		*out = make([]TagAccessLevel, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedTagParameters.
+func (in *ProtectedTagParameters) DeepCopy() *ProtectedTagParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedTagParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedTagSpec) DeepCopyInto(out *ProtectedTagSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedTagSpec.
+func (in *ProtectedTagSpec) DeepCopy() *ProtectedTagSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedTagSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedTagStatus) DeepCopyInto(out *ProtectedTagStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedTagStatus.
+func (in *ProtectedTagStatus) DeepCopy() *ProtectedTagStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedTagStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PushRules) DeepCopyInto(out *PushRules) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PushRules.
+func (in *PushRules) DeepCopy() *PushRules {
+	if in == nil {
+		return nil
+	}
+	out := new(PushRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PushRules) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.MirrorOverwritesDivergedBranches != nil {
-		in, out := &in.MirrorOverwritesDivergedBranches, &out.MirrorOverwritesDivergedBranches
-		*out = new(bool)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PushRulesList) DeepCopyInto(out *PushRulesList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PushRules, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.MirrorTriggerBuilds != nil {
-		in, out := &in.MirrorTriggerBuilds, &out.MirrorTriggerBuilds
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PushRulesList.
+func (in *PushRulesList) DeepCopy() *PushRulesList {
+	if in == nil {
+		return nil
 	}
-	if in.MirrorUserID != nil {
-		in, out := &in.MirrorUserID, &out.MirrorUserID
-		*out = new(int)
-		**out = **in
+	out := new(PushRulesList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PushRulesList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.NamespaceID != nil {
-		in, out := &in.NamespaceID, &out.NamespaceID
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PushRulesObservation) DeepCopyInto(out *PushRulesObservation) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
 		*out = new(int)
 		**out = **in
 	}
-	if in.NamespaceIDRef != nil {
-		in, out := &in.NamespaceIDRef, &out.NamespaceIDRef
-		*out = new(v1.Reference)
-		(*in).DeepCopyInto(*out)
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
 	}
-	if in.NamespaceIDSelector != nil {
-		in, out := &in.NamespaceIDSelector, &out.NamespaceIDSelector
-		*out = new(v1.Selector)
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(common.LastError)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.OnlyAllowMergeIfAllDiscussionsAreResolved != nil {
-		in, out := &in.OnlyAllowMergeIfAllDiscussionsAreResolved, &out.OnlyAllowMergeIfAllDiscussionsAreResolved
-		*out = new(bool)
-		**out = **in
-	}
-	if in.OnlyAllowMergeIfPipelineSucceeds != nil {
-		in, out := &in.OnlyAllowMergeIfPipelineSucceeds, &out.OnlyAllowMergeIfPipelineSucceeds
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PushRulesObservation.
+func (in *PushRulesObservation) DeepCopy() *PushRulesObservation {
+	if in == nil {
+		return nil
 	}
-	if in.OnlyMirrorProtectedBranches != nil {
-		in, out := &in.OnlyMirrorProtectedBranches, &out.OnlyMirrorProtectedBranches
-		*out = new(bool)
+	out := new(PushRulesObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PushRulesParameters) DeepCopyInto(out *PushRulesParameters) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
 		**out = **in
 	}
-	if in.OperationsAccessLevel != nil {
-		in, out := &in.OperationsAccessLevel, &out.OperationsAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
+	if in.ProjectIDRef != nil {
+		in, out := &in.ProjectIDRef, &out.ProjectIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.PackagesEnabled != nil {
-		in, out := &in.PackagesEnabled, &out.PackagesEnabled
-		*out = new(bool)
-		**out = **in
+	if in.ProjectIDSelector != nil {
+		in, out := &in.ProjectIDSelector, &out.ProjectIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.PagesAccessLevel != nil {
-		in, out := &in.PagesAccessLevel, &out.PagesAccessLevel
-		*out = new(AccessControlValue)
+	if in.CommitMessageRegex != nil {
+		in, out := &in.CommitMessageRegex, &out.CommitMessageRegex
+		*out = new(string)
 		**out = **in
 	}
-	if in.Path != nil {
-		in, out := &in.Path, &out.Path
+	if in.CommitMessageNegativeRegex != nil {
+		in, out := &in.CommitMessageNegativeRegex, &out.CommitMessageNegativeRegex
 		*out = new(string)
 		**out = **in
 	}
-	if in.PrintingMergeRequestLinkEnabled != nil {
-		in, out := &in.PrintingMergeRequestLinkEnabled, &out.PrintingMergeRequestLinkEnabled
-		*out = new(bool)
+	if in.BranchNameRegex != nil {
+		in, out := &in.BranchNameRegex, &out.BranchNameRegex
+		*out = new(string)
 		**out = **in
 	}
-	if in.PublicBuilds != nil {
-		in, out := &in.PublicBuilds, &out.PublicBuilds
-		*out = new(bool)
+	if in.AuthorEmailRegex != nil {
+		in, out := &in.AuthorEmailRegex, &out.AuthorEmailRegex
+		*out = new(string)
 		**out = **in
 	}
-	if in.RemoveSourceBranchAfterMerge != nil {
-		in, out := &in.RemoveSourceBranchAfterMerge, &out.RemoveSourceBranchAfterMerge
-		*out = new(bool)
+	if in.FileNameRegex != nil {
+		in, out := &in.FileNameRegex, &out.FileNameRegex
+		*out = new(string)
 		**out = **in
 	}
-	if in.RepositoryAccessLevel != nil {
-		in, out := &in.RepositoryAccessLevel, &out.RepositoryAccessLevel
-		*out = new(AccessControlValue)
+	if in.MaxFileSize != nil {
+		in, out := &in.MaxFileSize, &out.MaxFileSize
+		*out = new(int)
 		**out = **in
 	}
-	if in.RequestAccessEnabled != nil {
-		in, out := &in.RequestAccessEnabled, &out.RequestAccessEnabled
+	if in.DenyDeleteTag != nil {
+		in, out := &in.DenyDeleteTag, &out.DenyDeleteTag
 		*out = new(bool)
 		**out = **in
 	}
-	if in.ResolveOutdatedDiffDiscussions != nil {
-		in, out := &in.ResolveOutdatedDiffDiscussions, &out.ResolveOutdatedDiffDiscussions
+	if in.MemberCheck != nil {
+		in, out := &in.MemberCheck, &out.MemberCheck
 		*out = new(bool)
 		**out = **in
 	}
-	if in.ServiceDeskEnabled != nil {
-		in, out := &in.ServiceDeskEnabled, &out.ServiceDeskEnabled
+	if in.PreventSecrets != nil {
+		in, out := &in.PreventSecrets, &out.PreventSecrets
 		*out = new(bool)
 		**out = **in
 	}
-	if in.SharedRunnersEnabled != nil {
-		in, out := &in.SharedRunnersEnabled, &out.SharedRunnersEnabled
+	if in.CommitCommitterCheck != nil {
+		in, out := &in.CommitCommitterCheck, &out.CommitCommitterCheck
 		*out = new(bool)
 		**out = **in
 	}
-	if in.SnippetsAccessLevel != nil {
-		in, out := &in.SnippetsAccessLevel, &out.SnippetsAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
-	}
-	if in.SuggestionCommitMessage != nil {
-		in, out := &in.SuggestionCommitMessage, &out.SuggestionCommitMessage
-		*out = new(string)
-		**out = **in
-	}
-	if in.TagList != nil {
-		in, out := &in.TagList, &out.TagList
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.TemplateName != nil {
-		in, out := &in.TemplateName, &out.TemplateName
-		*out = new(string)
-		**out = **in
-	}
-	if in.TemplateProjectID != nil {
-		in, out := &in.TemplateProjectID, &out.TemplateProjectID
-		*out = new(int)
-		**out = **in
-	}
-	if in.UseCustomTemplate != nil {
-		in, out := &in.UseCustomTemplate, &out.UseCustomTemplate
+	if in.RejectUnsignedCommits != nil {
+		in, out := &in.RejectUnsignedCommits, &out.RejectUnsignedCommits
 		*out = new(bool)
 		**out = **in
 	}
-	if in.Visibility != nil {
-		in, out := &in.Visibility, &out.Visibility
-		*out = new(VisibilityValue)
-		**out = **in
-	}
-	if in.WikiAccessLevel != nil {
-		in, out := &in.WikiAccessLevel, &out.WikiAccessLevel
-		*out = new(AccessControlValue)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectParameters.
-func (in *ProjectParameters) DeepCopy() *ProjectParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PushRulesParameters.
+func (in *PushRulesParameters) DeepCopy() *PushRulesParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectParameters)
+	out := new(PushRulesParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
+func (in *PushRulesSpec) DeepCopyInto(out *PushRulesSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
-func (in *ProjectSpec) DeepCopy() *ProjectSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PushRulesSpec.
+func (in *PushRulesSpec) DeepCopy() *PushRulesSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectSpec)
+	out := new(PushRulesSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectStatistics) DeepCopyInto(out *ProjectStatistics) {
+func (in *PushRulesStatus) DeepCopyInto(out *PushRulesStatus) {
 	*out = *in
-	out.StorageStatistics = in.StorageStatistics
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatistics.
-func (in *ProjectStatistics) DeepCopy() *ProjectStatistics {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PushRulesStatus.
+func (in *PushRulesStatus) DeepCopy() *PushRulesStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectStatistics)
+	out := new(PushRulesStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
+func (in *SharedWithGroups) DeepCopyInto(out *SharedWithGroups) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
-func (in *ProjectStatus) DeepCopy() *ProjectStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedWithGroups.
+func (in *SharedWithGroups) DeepCopy() *SharedWithGroups {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectStatus)
+	out := new(SharedWithGroups)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SharedWithGroups) DeepCopyInto(out *SharedWithGroups) {
+func (in *SharedWithGroupsParameters) DeepCopyInto(out *SharedWithGroupsParameters) {
 	*out = *in
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int)
+		**out = **in
+	}
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupIDSelector != nil {
+		in, out := &in.GroupIDSelector, &out.GroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedWithGroups.
-func (in *SharedWithGroups) DeepCopy() *SharedWithGroups {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedWithGroupsParameters.
+func (in *SharedWithGroupsParameters) DeepCopy() *SharedWithGroupsParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(SharedWithGroups)
+	out := new(SharedWithGroupsParameters)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1812,6 +3008,41 @@ func (in *StorageStatistics) DeepCopy() *StorageStatistics {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagAccessLevel) DeepCopyInto(out *TagAccessLevel) {
+	*out = *in
+	if in.AccessLevel != nil {
+		in, out := &in.AccessLevel, &out.AccessLevel
+		*out = new(AccessLevelValue)
+		**out = **in
+	}
+	if in.UserID != nil {
+		in, out := &in.UserID, &out.UserID
+		*out = new(int)
+		**out = **in
+	}
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int)
+		**out = **in
+	}
+	if in.DeployKeyID != nil {
+		in, out := &in.DeployKeyID, &out.DeployKeyID
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagAccessLevel.
+func (in *TagAccessLevel) DeepCopy() *TagAccessLevel {
+	if in == nil {
+		return nil
+	}
+	out := new(TagAccessLevel)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *User) DeepCopyInto(out *User) {
 	*out = *in
@@ -1943,6 +3174,26 @@ func (in *VariableList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VariableObservation) DeepCopyInto(out *VariableObservation) {
+	*out = *in
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(common.LastError)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableObservation.
+func (in *VariableObservation) DeepCopy() *VariableObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(VariableObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VariableParameters) DeepCopyInto(out *VariableParameters) {
 	*out = *in
@@ -2029,6 +3280,7 @@ func (in *VariableSpec) DeepCopy() *VariableSpec {
 func (in *VariableStatus) DeepCopyInto(out *VariableStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableStatus.
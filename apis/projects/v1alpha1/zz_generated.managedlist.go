@@ -29,6 +29,33 @@ func (l *AccessTokenList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this ApprovalRuleList.
+func (l *ApprovalRuleList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this ApprovalSettingsList.
+func (l *ApprovalSettingsList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this BranchList.
+func (l *BranchList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this DeployKeyList.
 func (l *DeployKeyList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -83,6 +110,33 @@ func (l *ProjectList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this ProtectedBranchList.
+func (l *ProtectedBranchList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this ProtectedTagList.
+func (l *ProtectedTagList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this PushRulesList.
+func (l *PushRulesList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this VariableList.
 func (l *VariableList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
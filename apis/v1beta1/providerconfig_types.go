@@ -33,11 +33,146 @@ type ProviderConfigSpec struct {
 	// InsecureSkipVerify ignores self signed TLS certificates when connecting
 	// to Gitlab.
 	InsecureSkipVerify *bool `json:"insecureSkipVerify,omitempty"`
+
+	// CABundleSecretRef references a PEM encoded bundle of CA certificates
+	// that are trusted in addition to the system roots when connecting to
+	// Gitlab.
+	// +optional
+	CABundleSecretRef *xpv1.SecretKeySelector `json:"caBundleSecretRef,omitempty"`
+
+	// ClientCertSecretRef references a PEM encoded client certificate that is
+	// presented to Gitlab for mutual TLS. Requires ClientKeySecretRef.
+	// +optional
+	ClientCertSecretRef *xpv1.SecretKeySelector `json:"clientCertSecretRef,omitempty"`
+
+	// ClientKeySecretRef references the PEM encoded private key of the client
+	// certificate in ClientCertSecretRef.
+	// +optional
+	ClientKeySecretRef *xpv1.SecretKeySelector `json:"clientKeySecretRef,omitempty"`
+
+	// ProxyURL of the HTTP(S) proxy used to connect to Gitlab. Defaults to
+	// the HTTPS_PROXY and HTTP_PROXY environment variables of the provider.
+	// +optional
+	ProxyURL *string `json:"proxyURL,omitempty"`
+
+	// NoProxy is a comma separated list of hosts, domains and CIDRs that are
+	// connected to directly, in the format of the NO_PROXY environment
+	// variable which it overrides.
+	// +optional
+	NoProxy *string `json:"noProxy,omitempty"`
+
+	// ExtraHeaders are added to every request sent to Gitlab, e.g. to pass an
+	// authenticating gateway in front of it.
+	// +optional
+	ExtraHeaders []ExtraHeader `json:"extraHeaders,omitempty"`
+
+	// AuthMethod selects how the credentials are presented to Gitlab.
+	// personal uses them as a personal, group or project access token, job
+	// as a CI job token, basic as the password of Username and oauth as an
+	// OAuth2 access token or, if OAuth is set, as the secret of the OAuth2
+	// flow configured there.
+	// +kubebuilder:validation:Enum=personal;oauth;job;basic
+	// +kubebuilder:default=personal
+	// +optional
+	AuthMethod *AuthMethod `json:"authMethod,omitempty"`
+
+	// Username to authenticate as when AuthMethod is basic.
+	// +optional
+	Username *string `json:"username,omitempty"`
+
+	// OAuth configures the OAuth2 application flow used when AuthMethod is
+	// oauth. Access tokens are requested and refreshed transparently.
+	// +optional
+	OAuth *OAuthConfig `json:"oauth,omitempty"`
+
+	// Rotation enables the provider to rotate its own access token before it
+	// expires and to write the new token back into the credentials Secret.
+	// If the credentials Secret cannot be updated, the new token is written
+	// into a new Secret named after it with a -rotated- suffix, which is
+	// recorded in the status and used until the token could be written back.
+	// Requires the Secret credentials source and the personal AuthMethod.
+	// +optional
+	Rotation *TokenRotation `json:"rotation,omitempty"`
+}
+
+// TokenRotation configures the self-rotation of the provider's access token.
+type TokenRotation struct {
+	// RotateBefore is how long before its expiry the token is rotated. It
+	// must be shorter than the lifetime of the rotated token, or the token is
+	// rotated on every check. Defaults to 72h.
+	// +optional
+	RotateBefore *metav1.Duration `json:"rotateBefore,omitempty"`
+
+	// ExpiresAfter is the lifetime of the rotated token, rounded to full days.
+	// Defaults to the lifetime Gitlab assigns, which is one week.
+	// +optional
+	ExpiresAfter *metav1.Duration `json:"expiresAfter,omitempty"`
+}
+
+// An ExtraHeader is an HTTP header whose value is read from a Secret.
+type ExtraHeader struct {
+	// Name of the header.
+	Name string `json:"name"`
+
+	// ValueSecretRef references the value of the header.
+	ValueSecretRef xpv1.SecretKeySelector `json:"valueSecretRef"`
+}
+
+// AuthMethod is the method used to authenticate to Gitlab.
+type AuthMethod string
+
+// Supported authentication methods.
+const (
+	AuthMethodPersonal AuthMethod = "personal"
+	AuthMethodOAuth    AuthMethod = "oauth"
+	AuthMethodJob      AuthMethod = "job"
+	AuthMethodBasic    AuthMethod = "basic"
+)
+
+// OAuthGrantType is the OAuth2 grant used to obtain access tokens.
+type OAuthGrantType string
+
+// Supported OAuth2 grant types.
+const (
+	OAuthGrantClientCredentials OAuthGrantType = "ClientCredentials"
+	OAuthGrantRefreshToken      OAuthGrantType = "RefreshToken"
+)
+
+// OAuthConfig configures the OAuth2 application the provider authenticates as.
+type OAuthConfig struct {
+	// GrantType used to obtain access tokens. With ClientCredentials the
+	// credentials hold the application secret, with RefreshToken they hold
+	// the refresh token used to obtain the first access token. Gitlab issues
+	// a new refresh token with every access token, which is written back into
+	// the credentials if they are read from a Secret.
+	// +kubebuilder:validation:Enum=ClientCredentials;RefreshToken
+	GrantType OAuthGrantType `json:"grantType"`
+
+	// ClientID of the OAuth2 application.
+	ClientID string `json:"clientId"`
+
+	// ClientSecretRef references the secret of the OAuth2 application when
+	// GrantType is RefreshToken. Public applications don't need one.
+	// +optional
+	ClientSecretRef *xpv1.SecretKeySelector `json:"clientSecretRef,omitempty"`
+
+	// Scopes requested for the access token.
+	// +optional
+	Scopes []string `json:"scopes,omitempty"`
+
+	// TokenURL of the OAuth2 token endpoint. Defaults to /oauth/token on the
+	// Gitlab instance configured in BaseURL.
+	// +optional
+	TokenURL *string `json:"tokenURL,omitempty"`
 }
 
 // ProviderCredentials required to authenticate.
 type ProviderCredentials struct {
 	// Source of the provider credentials.
+	// Secret, Environment and Filesystem read the token through the matching
+	// selector and are re-read on every connection, so rotated tokens are
+	// picked up without a restart. InjectedIdentity reads the token from the
+	// GITLAB_TOKEN environment variable of the provider pod.
 	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem
 	Source xpv1.CredentialsSource `json:"source"`
 
@@ -47,11 +182,48 @@ type ProviderCredentials struct {
 // A ProviderConfigStatus represents the status of a ProviderConfig.
 type ProviderConfigStatus struct {
 	xpv1.ProviderConfigStatus `json:",inline"`
+
+	// Username of the user the credentials authenticate as.
+	// +optional
+	Username string `json:"username,omitempty"`
+
+	// TokenExpiresAt is the expiry date of the access token.
+	// +optional
+	TokenExpiresAt *metav1.Time `json:"tokenExpiresAt,omitempty"`
+
+	// TokenScopes are the scopes granted to the access token.
+	// +optional
+	TokenScopes []string `json:"tokenScopes,omitempty"`
+
+	// Version of the Gitlab instance.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Revision of the Gitlab instance.
+	// +optional
+	Revision string `json:"revision,omitempty"`
+
+	// LastCheckTime is when the credentials were last checked against Gitlab.
+	// +optional
+	LastCheckTime *metav1.Time `json:"lastCheckTime,omitempty"`
+
+	// LastRotationTime is when the access token was last rotated.
+	// +optional
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+
+	// RotatedCredentialsSecretRef references the Secret the rotated access
+	// token was written to because the credentials Secret could not be
+	// updated. The token is read from it until it has been written back into
+	// the credentials Secret, after which the Secret is deleted.
+	// +optional
+	RotatedCredentialsSecretRef *xpv1.SecretKeySelector `json:"rotatedCredentialsSecretRef,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 
 // A ProviderConfig configures how gitlab controller should connect to Gitlab API.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".status.version"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentials.secretRef.name",priority=1
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,provider,gitlab}
@@ -41,6 +41,7 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
 )
 
 func main() {
@@ -55,6 +56,7 @@ func main() {
 		namespace                  = app.Flag("namespace", "Namespace used to set as default scope in default secret store config.").Default("crossplane-system").Envar("POD_NAMESPACE").String()
 		enableExternalSecretStores = app.Flag("enable-external-secret-stores", "Enable support for ExternalSecretStores.").Default("false").Envar("ENABLE_EXTERNAL_SECRET_STORES").Bool()
 		enableManagementPolicies   = app.Flag("enable-management-policies", "Enable support for Management Policies.").Default("false").Envar("ENABLE_MANAGEMENT_POLICIES").Bool()
+		enableTracing              = app.Flag("enable-tracing", "Export traces of reconciles and Gitlab API calls over OTLP/HTTP, configured by the OTEL_EXPORTER_OTLP_* environment variables.").Default("false").Envar("ENABLE_TRACING").Bool()
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
@@ -69,6 +71,20 @@ func main() {
 
 	log.Debug("Starting", "sync-period", syncInterval.String())
 
+	if *enableTracing {
+		shutdown, err := tracing.Setup(context.Background())
+		kingpin.FatalIfError(err, "Cannot setup tracing")
+		defer func() {
+			// Flush the spans of the last reconciles before exiting.
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := shutdown(ctx); err != nil {
+				log.Info("Cannot flush traces", "error", err)
+			}
+		}()
+		log.Info("Tracing enabled")
+	}
+
 	cfg, err := ctrl.GetConfig()
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
 
@@ -0,0 +1,305 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/pkg/errors"
+	gitlab "github.com/xanzy/go-gitlab"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+)
+
+const (
+	defaultBaseURL = "https://gitlab.com/"
+	apiVersionPath = "api/v4"
+
+	errNoUsername         = "username is required for basic authentication"
+	errNoOAuthClientID    = "oauth.clientId is required for the OAuth2 application flow"
+	errGetOAuthSecret     = "cannot get OAuth2 client secret"
+	errUnknownGrantType   = "unknown OAuth2 grant type %q"
+	errUnknownAuthMethod  = "unknown authentication method %q"
+	errRequestOAuthToken  = "cannot obtain OAuth2 access token"
+	errOAuthNotConfigured = "oauth is set but authMethod is not oauth"
+
+	// refreshTokenWriteTimeout bounds writing a rotated refresh token back
+	// into the credentials Secret.
+	refreshTokenWriteTimeout = 30 * time.Second
+)
+
+// OAuthConfig holds the resolved OAuth2 application settings of a Config.
+type OAuthConfig struct {
+	GrantType    v1beta1.OAuthGrantType
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	TokenURL     string
+
+	// writeRefreshToken stores a refresh token that Gitlab rotated where
+	// the token of the Config was read from, if it can be written to.
+	writeRefreshToken func(token string) error
+}
+
+// useAuthMethod validates the authentication settings of the supplied
+// ProviderConfig and resolves the secrets they reference into cfg.
+func useAuthMethod(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, cfg *Config) error {
+	switch cfg.AuthMethod {
+	case v1beta1.AuthMethodPersonal, v1beta1.AuthMethodJob:
+	case v1beta1.AuthMethodBasic:
+		if cfg.Username == "" {
+			return errors.New(errNoUsername)
+		}
+	case v1beta1.AuthMethodOAuth:
+		o := pc.Spec.OAuth
+		if o == nil {
+			return nil
+		}
+		if o.ClientID == "" {
+			return errors.New(errNoOAuthClientID)
+		}
+		cfg.OAuth = &OAuthConfig{
+			GrantType: o.GrantType,
+			ClientID:  o.ClientID,
+			Scopes:    o.Scopes,
+			TokenURL:  oauthTokenURL(pc.Spec.BaseURL),
+		}
+		if o.TokenURL != nil {
+			cfg.OAuth.TokenURL = *o.TokenURL
+		}
+		if ref := o.ClientSecretRef; ref != nil {
+			secret, err := getSecretValue(ctx, c, ref)
+			if err != nil {
+				return errors.Wrap(err, errGetOAuthSecret)
+			}
+			cfg.OAuth.ClientSecret = string(secret)
+		}
+		if cd := pc.Spec.Credentials; o.GrantType == v1beta1.OAuthGrantRefreshToken && cd.Source == xpv1.CredentialsSourceSecret && cd.SecretRef != nil {
+			cfg.OAuth.writeRefreshToken = secretKeyWriter(c, *cd.SecretRef)
+		}
+	default:
+		return errors.Errorf(errUnknownAuthMethod, cfg.AuthMethod)
+	}
+	if pc.Spec.OAuth != nil && cfg.AuthMethod != v1beta1.AuthMethodOAuth {
+		return errors.New(errOAuthNotConfigured)
+	}
+	return nil
+}
+
+// newAuthenticatedClient creates a Gitlab client that authenticates with the
+// method selected in the supplied Config. httpclient is used for requests to
+// the OAuth2 token endpoint.
+func newAuthenticatedClient(c Config, httpclient *http.Client, options ...gitlab.ClientOptionFunc) (*gitlab.Client, error) {
+	switch c.AuthMethod {
+	case v1beta1.AuthMethodOAuth:
+		if c.OAuth == nil {
+			return gitlab.NewOAuthClient(c.Token, options...)
+		}
+		ts, err := oauthTokenSource(c, httpclient)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, gitlab.WithRequestOptions(withOAuthTokenSource(ts)))
+		return gitlab.NewOAuthClient("", options...)
+	case v1beta1.AuthMethodJob:
+		return gitlab.NewJobClient(c.Token, options...)
+	case v1beta1.AuthMethodBasic:
+		return gitlab.NewBasicAuthClient(c.Username, c.Token, options...)
+	default:
+		return gitlab.NewClient(c.Token, options...)
+	}
+}
+
+// withOAuthTokenSource sets the Authorization header of every request from
+// the supplied token source. go-gitlab only sets its own static OAuth header
+// if none is present yet.
+func withOAuthTokenSource(ts oauth2.TokenSource) gitlab.RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		t, err := ts.Token()
+		if err != nil {
+			return errors.Wrap(err, errRequestOAuthToken)
+		}
+		t.SetAuthHeader(req.Request)
+		return nil
+	}
+}
+
+// tokenSources caches the OAuth2 token source of each ProviderConfig for the
+// lifetime of the provider. Access tokens are thus reused across reconciles
+// and refreshed only when they expire, which matters because Gitlab
+// invalidates a refresh token once it has been used. A token source is
+// replaced once the OAuth2 settings of its ProviderConfig change or its
+// credentials are replaced by a token the source did not hand out.
+var tokenSources = struct {
+	sync.Mutex
+	m map[string]cachedTokenSource
+}{m: map[string]cachedTokenSource{}}
+
+type cachedTokenSource struct {
+	key string
+	ts  *persistingTokenSource
+}
+
+func oauthTokenSource(c Config, httpclient *http.Client) (oauth2.TokenSource, error) {
+	o := c.OAuth
+	// The token is left out of the key, since Gitlab rotates refresh tokens
+	// and they are written back into the credentials.
+	key := sha256.Sum256([]byte(strings.Join([]string{string(o.GrantType), o.TokenURL, o.ClientID, o.ClientSecret, strings.Join(o.Scopes, " ")}, "\x00")))
+	k := hex.EncodeToString(key[:])
+	id := string(c.cacheUID)
+	if id == "" {
+		id = k
+	}
+
+	tokenSources.Lock()
+	defer tokenSources.Unlock()
+	if e, ok := tokenSources.m[id]; ok && e.key == k && e.ts.owns(c.Token) {
+		return e.ts, nil
+	}
+
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, httpclient)
+	var ts oauth2.TokenSource
+	switch o.GrantType {
+	case v1beta1.OAuthGrantClientCredentials:
+		cc := &clientcredentials.Config{
+			ClientID:     o.ClientID,
+			ClientSecret: c.Token,
+			TokenURL:     o.TokenURL,
+			Scopes:       o.Scopes,
+		}
+		ts = cc.TokenSource(ctx)
+	case v1beta1.OAuthGrantRefreshToken:
+		oc := &oauth2.Config{
+			ClientID:     o.ClientID,
+			ClientSecret: o.ClientSecret,
+			Endpoint:     oauth2.Endpoint{TokenURL: o.TokenURL},
+			Scopes:       o.Scopes,
+		}
+		ts = oc.TokenSource(ctx, &oauth2.Token{RefreshToken: c.Token})
+	default:
+		return nil, errors.Errorf(errUnknownGrantType, o.GrantType)
+	}
+	pts := &persistingTokenSource{TokenSource: ts, write: o.writeRefreshToken, current: c.Token}
+	tokenSources.m[id] = cachedTokenSource{key: k, ts: pts}
+	return pts, nil
+}
+
+// RefreshTokenRetryInterval is how long writing a rotated refresh token back
+// into the credentials is not attempted again after it failed. It matches
+// the default poll interval of the managed resources.
+var RefreshTokenRetryInterval = time.Minute
+
+// persistingTokenSource writes the refresh tokens Gitlab rotates while
+// refreshing access tokens back into the credentials they were read from, so
+// the provider keeps access to Gitlab once it restarts.
+type persistingTokenSource struct {
+	oauth2.TokenSource
+	write func(token string) error
+
+	mu       sync.Mutex
+	current  string
+	previous string
+	// failed is when writing a refresh token last failed.
+	failed time.Time
+}
+
+// Token returns a valid access token. A rotated refresh token that cannot be
+// written is tried again with the next token once RefreshTokenRetryInterval
+// has passed.
+func (s *persistingTokenSource) Token() (*oauth2.Token, error) {
+	t, err := s.TokenSource.Token()
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if t.RefreshToken == "" || t.RefreshToken == s.current {
+		return t, nil
+	}
+	if s.write != nil {
+		if !s.failed.IsZero() && time.Since(s.failed) < RefreshTokenRetryInterval {
+			return t, nil
+		}
+		if s.write(t.RefreshToken) != nil {
+			s.failed = time.Now()
+			return t, nil
+		}
+		s.failed = time.Time{}
+	}
+	s.previous, s.current = s.current, t.RefreshToken
+	return t, nil
+}
+
+// owns returns true if the supplied credentials are the ones the token source
+// was created from or has written back. The previous credentials are
+// accepted too, as they may still be read from a stale cache.
+func (s *persistingTokenSource) owns(token string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return token == s.current || (s.previous != "" && token == s.previous)
+}
+
+// secretKeyWriter returns a function that writes a token into the supplied
+// key of a Secret.
+func secretKeyWriter(c client.Client, ref xpv1.SecretKeySelector) func(token string) error {
+	// The writer outlives the resolution of the Config, whose Secrets it must
+	// not be recorded with.
+	if r, ok := c.(*secretRecorder); ok {
+		c = r.Client
+	}
+	return func(token string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), refreshTokenWriteTimeout)
+		defer cancel()
+		return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			s := &corev1.Secret{}
+			if err := c.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+				return err
+			}
+			if s.Data == nil {
+				s.Data = map[string][]byte{}
+			}
+			s.Data[ref.Key] = []byte(token)
+			return c.Update(ctx, s)
+		})
+	}
+}
+
+// oauthTokenURL returns the OAuth2 token endpoint of the Gitlab instance
+// serving the supplied API base URL.
+func oauthTokenURL(baseURL string) string {
+	if baseURL == "" {
+		baseURL = defaultBaseURL
+	}
+	u := strings.TrimSuffix(baseURL, "/")
+	u = strings.TrimSuffix(u, apiVersionPath)
+	return strings.TrimSuffix(u, "/") + "/oauth/token"
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	gitlab "github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+)
+
+const (
+	errGetInformer     = "cannot get informer"
+	errAddEventHandler = "cannot add event handler"
+)
+
+// clientCache holds the Gitlab client built for each ProviderConfig. The
+// client, and with it its connection pool, is shared by all controllers for
+// as long as its cache key is unchanged.
+var clientCache = struct {
+	sync.Mutex
+	m map[types.UID]cachedClient
+}{m: map[types.UID]cachedClient{}}
+
+type cachedClient struct {
+	key    string
+	client *gitlab.Client
+}
+
+// clientCacheKey returns the key of the client built from the supplied Config
+// for the supplied ProviderConfig. It changes with the generation of the
+// ProviderConfig and the resourceVersion of its credentials Secret. Since
+// credentials read from the filesystem or environment and secrets referenced
+// from elsewhere carry no version, the key also includes a hash of the whole
+// resolved Config.
+func clientCacheKey(pc *v1beta1.ProviderConfig, secretVersion string, cfg Config) string {
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%d/%s/%x", pc.GetGeneration(), secretVersion, sha256.Sum256(b))
+}
+
+// cachedClientFor returns the cached client of the supplied Config, building
+// and caching a new one if the Config changed since the last call.
+func cachedClientFor(c Config, build func(Config) *gitlab.Client) *gitlab.Client {
+	if c.cacheUID == "" || c.cacheKey == "" {
+		return build(c)
+	}
+	clientCache.Lock()
+	defer clientCache.Unlock()
+	if e, ok := clientCache.m[c.cacheUID]; ok && e.key == c.cacheKey {
+		return e.client
+	}
+	cl := build(c)
+	clientCache.m[c.cacheUID] = cachedClient{key: c.cacheKey, client: cl}
+	return cl
+}
+
+// configCache holds the Config resolved from each ProviderConfig whose
+// credentials are read from a Secret. Entries are evicted by the informers of
+// the manager as soon as their ProviderConfig or one of the Secrets it
+// references changes, so the ProviderConfig and its Secrets are only read and
+// resolved again after they changed. The cache is disabled until
+// SetupConfigCache is called.
+var configCache = struct {
+	sync.Mutex
+	enabled bool
+	// epoch is increased with every eviction. A Config is only cached if
+	// nothing was evicted while it was resolved, as it may have been resolved
+	// from the evicted objects.
+	epoch uint64
+	m     map[string]cachedConfig
+}{m: map[string]cachedConfig{}}
+
+type cachedConfig struct {
+	cfg     Config
+	secrets []types.NamespacedName
+}
+
+// SetupConfigCache enables the cache of resolved Configs and evicts its
+// entries on changes to ProviderConfigs and Secrets seen by the informers of
+// the supplied manager. The clients, instances and token sources of deleted
+// ProviderConfigs are dropped as well.
+func SetupConfigCache(mgr ctrl.Manager, _ controller.Options) error {
+	evictors := map[client.Object]func(o client.Object, deleted bool){
+		&v1beta1.ProviderConfig{}: func(o client.Object, deleted bool) {
+			evictProviderConfig(o.GetName())
+			if deleted {
+				forgetProviderConfig(o.GetUID())
+			}
+		},
+		&corev1.Secret{}: func(o client.Object, _ bool) { evictSecret(client.ObjectKeyFromObject(o)) },
+	}
+	for o, evict := range evictors {
+		i, err := mgr.GetCache().GetInformer(context.Background(), o)
+		if err != nil {
+			return errors.Wrap(err, errGetInformer)
+		}
+		if _, err := i.AddEventHandler(evictingHandler(evict)); err != nil {
+			return errors.Wrap(err, errAddEventHandler)
+		}
+	}
+
+	configCache.Lock()
+	defer configCache.Unlock()
+	configCache.enabled = true
+	return nil
+}
+
+func evictingHandler(evict func(o client.Object, deleted bool)) toolscache.ResourceEventHandler {
+	handle := func(obj interface{}, deleted bool) {
+		if d, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+			obj = d.Obj
+		}
+		if o, ok := obj.(client.Object); ok {
+			evict(o, deleted)
+		}
+	}
+	return toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { handle(obj, false) },
+		UpdateFunc: func(_, obj interface{}) { handle(obj, false) },
+		DeleteFunc: func(obj interface{}) { handle(obj, true) },
+	}
+}
+
+// forgetProviderConfig drops the client, instances and OAuth2 token source
+// cached for the ProviderConfig with the supplied UID.
+func forgetProviderConfig(uid types.UID) {
+	clientCache.Lock()
+	delete(clientCache.m, uid)
+	clientCache.Unlock()
+
+	instances.Lock()
+	for k := range instances.m {
+		if strings.HasPrefix(k, string(uid)+"|") {
+			delete(instances.m, k)
+		}
+	}
+	instances.Unlock()
+
+	tokenSources.Lock()
+	delete(tokenSources.m, string(uid))
+	tokenSources.Unlock()
+}
+
+// cachedConfigFor returns the cached Config of the named ProviderConfig. It
+// also returns the current epoch of the cache, which must be passed to
+// cacheConfig when caching a newly resolved Config.
+func cachedConfigFor(name string) (*Config, uint64, bool) {
+	configCache.Lock()
+	defer configCache.Unlock()
+	e, ok := configCache.m[name]
+	if !configCache.enabled || !ok {
+		return nil, configCache.epoch, false
+	}
+	cfg := e.cfg
+	return &cfg, configCache.epoch, true
+}
+
+// cacheConfig caches the Config of the named ProviderConfig, which was
+// resolved from the supplied Secrets, unless an entry was evicted since the
+// supplied epoch.
+func cacheConfig(name string, epoch uint64, cfg Config, secrets []types.NamespacedName) {
+	configCache.Lock()
+	defer configCache.Unlock()
+	if !configCache.enabled || epoch != configCache.epoch {
+		return
+	}
+	configCache.m[name] = cachedConfig{cfg: cfg, secrets: secrets}
+}
+
+func evictProviderConfig(name string) {
+	configCache.Lock()
+	defer configCache.Unlock()
+	configCache.epoch++
+	delete(configCache.m, name)
+}
+
+func evictSecret(key types.NamespacedName) {
+	configCache.Lock()
+	defer configCache.Unlock()
+	configCache.epoch++
+	for name, e := range configCache.m {
+		for _, s := range e.secrets {
+			if s == key {
+				delete(configCache.m, name)
+				break
+			}
+		}
+	}
+}
+
+// secretRecorder is a client that records the Secrets read through it.
+type secretRecorder struct {
+	client.Client
+	secrets []types.NamespacedName
+}
+
+func (r *secretRecorder) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	if _, ok := obj.(*corev1.Secret); ok {
+		r.secrets = append(r.secrets, key)
+	}
+	return r.Client.Get(ctx, key, obj, opts...)
+}
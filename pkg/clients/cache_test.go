@@ -0,0 +1,191 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gitlab "github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+)
+
+func TestClientCacheKey(t *testing.T) {
+	pc := func(generation int64) *v1beta1.ProviderConfig {
+		return &v1beta1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{UID: "uid", Generation: generation}}
+	}
+	base := clientCacheKey(pc(1), "10", Config{Token: "a"})
+
+	cases := map[string]struct {
+		key     string
+		changed bool
+	}{
+		"Unchanged":         {key: clientCacheKey(pc(1), "10", Config{Token: "a"}), changed: false},
+		"GenerationChanged": {key: clientCacheKey(pc(2), "10", Config{Token: "a"}), changed: true},
+		"SecretChanged":     {key: clientCacheKey(pc(1), "11", Config{Token: "a"}), changed: true},
+		"TokenChanged":      {key: clientCacheKey(pc(1), "", Config{Token: "b"}), changed: true},
+		"CABundleChanged":   {key: clientCacheKey(pc(1), "10", Config{Token: "a", CABundle: []byte("ca")}), changed: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.changed, tc.key != base); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCachedClientFor(t *testing.T) {
+	builds := 0
+	build := func(_ Config) *gitlab.Client {
+		builds++
+		return &gitlab.Client{}
+	}
+
+	uncached := Config{}
+	first := Config{cacheUID: "cached-client-test", cacheKey: "1"}
+	second := Config{cacheUID: "cached-client-test", cacheKey: "2"}
+
+	a := cachedClientFor(first, build)
+	b := cachedClientFor(first, build)
+	if a != b {
+		t.Errorf("cachedClientFor(...): want cached client for unchanged key")
+	}
+	if c := cachedClientFor(second, build); c == a {
+		t.Errorf("cachedClientFor(...): want new client for changed key")
+	}
+	if c := cachedClientFor(second, build); c == a {
+		t.Errorf("cachedClientFor(...): want old client to be evicted")
+	}
+	cachedClientFor(uncached, build)
+	cachedClientFor(uncached, build)
+	if diff := cmp.Diff(4, builds); diff != "" {
+		t.Errorf("builds: -want, +got:\n%s", diff)
+	}
+}
+
+func TestConfigCache(t *testing.T) {
+	configCache.Lock()
+	configCache.enabled = true
+	configCache.Unlock()
+	defer func() {
+		configCache.Lock()
+		configCache.enabled = false
+		configCache.m = map[string]cachedConfig{}
+		configCache.Unlock()
+	}()
+
+	secret := types.NamespacedName{Namespace: "crossplane-system", Name: "gitlab"}
+	var pcGets, secretGets int
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			switch o := obj.(type) {
+			case *v1beta1.ProviderConfig:
+				pcGets++
+				o.SetName(key.Name)
+				o.Spec.Credentials = v1beta1.ProviderCredentials{
+					Source: xpv1.CredentialsSourceSecret,
+					CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Namespace: secret.Namespace, Name: secret.Name},
+						Key:             "token",
+					}},
+				}
+			case *corev1.Secret:
+				secretGets++
+				o.Data = map[string][]byte{"token": []byte("token")}
+			default:
+				return kerrors.NewNotFound(corev1.Resource("providerconfigusages"), key.Name)
+			}
+			return nil
+		},
+		MockCreate: test.NewMockCreateFn(nil),
+	}
+	mg := &fake.Managed{
+		ObjectMeta:               metav1.ObjectMeta{Name: "mg"},
+		ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "config-cache-test"}},
+	}
+	use := func(wantPCGets, wantSecretGets int) {
+		t.Helper()
+		cfg, err := UseProviderConfig(context.Background(), kube, mg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff("token", cfg.Token); diff != "" {
+			t.Errorf("token: -want, +got:\n%s", diff)
+		}
+		if diff := cmp.Diff([]int{wantPCGets, wantSecretGets}, []int{pcGets, secretGets}); diff != "" {
+			t.Errorf("gets of ProviderConfig and Secret: -want, +got:\n%s", diff)
+		}
+	}
+
+	use(1, 1)
+	use(1, 1)
+
+	evictSecret(types.NamespacedName{Namespace: secret.Namespace, Name: "other"})
+	use(1, 1)
+
+	evictSecret(secret)
+	use(2, 2)
+	use(2, 2)
+
+	evictProviderConfig("config-cache-test")
+	use(3, 3)
+
+	// A Config resolved while an entry was evicted is not cached.
+	_, epoch, _ := cachedConfigFor("stale")
+	evictProviderConfig("other")
+	cacheConfig("stale", epoch, Config{}, nil)
+	if _, _, ok := cachedConfigFor("stale"); ok {
+		t.Errorf("cacheConfig(...): want Config resolved before an eviction not to be cached")
+	}
+}
+
+func TestForgetProviderConfig(t *testing.T) {
+	uid := types.UID("forget-provider-config-test")
+	clientCache.m[uid] = cachedClient{}
+	instances.m[string(uid)+"|https://gitlab.com/"] = cachedInstance{}
+	instances.m["other|https://gitlab.com/"] = cachedInstance{}
+	tokenSources.m[string(uid)] = cachedTokenSource{}
+	defer delete(instances.m, "other|https://gitlab.com/")
+
+	forgetProviderConfig(uid)
+
+	if _, ok := clientCache.m[uid]; ok {
+		t.Errorf("forgetProviderConfig(...): want client to be forgotten")
+	}
+	if _, ok := instances.m[string(uid)+"|https://gitlab.com/"]; ok {
+		t.Errorf("forgetProviderConfig(...): want instance to be forgotten")
+	}
+	if _, ok := instances.m["other|https://gitlab.com/"]; !ok {
+		t.Errorf("forgetProviderConfig(...): want instance of other ProviderConfig to be kept")
+	}
+	if _, ok := tokenSources.m[string(uid)]; ok {
+		t.Errorf("forgetProviderConfig(...): want token source to be forgotten")
+	}
+}
@@ -0,0 +1,489 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	gitlab "github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
+)
+
+// An ErrorKind classifies the errors returned by the Gitlab API.
+type ErrorKind string
+
+// Kinds of errors returned by the Gitlab API.
+const (
+	ErrorKindNotFound     ErrorKind = "NotFound"
+	ErrorKindUnauthorized ErrorKind = "Unauthorized"
+	ErrorKindForbidden    ErrorKind = "Forbidden"
+	ErrorKindConflict     ErrorKind = "Conflict"
+	ErrorKindValidation   ErrorKind = "ValidationFailed"
+	ErrorKindRateLimited  ErrorKind = "RateLimited"
+	ErrorKindServerError  ErrorKind = "ServerError"
+	ErrorKindOther        ErrorKind = "Other"
+)
+
+// Retryable returns true if a request that failed with an error of this kind
+// may succeed when it is sent again unchanged. Requests that were rejected for
+// their content or their credentials are not retryable.
+func (k ErrorKind) Retryable() bool {
+	switch k {
+	case ErrorKindUnauthorized, ErrorKindForbidden, ErrorKindValidation:
+		return false
+	default:
+		return true
+	}
+}
+
+// Condition types and reasons of managed resources whose last request to
+// Gitlab failed.
+const (
+	TypeAPIError xpv1.ConditionType = "APIError"
+
+	ReasonAPISucceeded xpv1.ConditionReason = "Succeeded"
+)
+
+// TerminalErrorBackoff is how long the Create or Update of a managed resource
+// is not retried after it failed with an error that is not Retryable, unless
+// the managed resource is changed in the meantime.
+var TerminalErrorBackoff = 5 * time.Minute
+
+// An Error is an error returned by the Gitlab API, classified by the status
+// code of its response.
+type Error struct {
+	Kind       ErrorKind
+	StatusCode int
+
+	// Fields holds the messages Gitlab reported for individual fields of
+	// requests that failed validation, keyed by field name.
+	Fields map[string][]string
+
+	err error
+}
+
+// Error returns the message of the error. Validation errors with per field
+// messages are summarized as e.g. "Bad Request: path has already been taken"
+// instead of the raw response of Gitlab.
+func (e *Error) Error() string {
+	if len(e.Fields) == 0 {
+		return e.err.Error()
+	}
+	return fmt.Sprintf("%s: %s", http.StatusText(e.StatusCode), strings.Join(e.FieldMessages(), "; "))
+}
+
+// Unwrap returns the error the Error was classified from.
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+// FieldMessages returns the per field validation messages of the error like
+// "path has already been taken", sorted by field.
+func (e *Error) FieldMessages() []string {
+	msgs := make([]string, 0, len(e.Fields))
+	for _, f := range e.fieldErrors() {
+		for _, m := range f.Messages {
+			msgs = append(msgs, f.Field+" "+m)
+		}
+	}
+	return msgs
+}
+
+func (e *Error) fieldErrors() []FieldError {
+	if len(e.Fields) == 0 {
+		return nil
+	}
+	fields := make([]FieldError, 0, len(e.Fields))
+	for f, m := range e.Fields {
+		fields = append(fields, FieldError{Field: f, Messages: m})
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Field < fields[j].Field })
+	return fields
+}
+
+// A FieldError holds the validation messages Gitlab returned for a single
+// field of a request.
+type FieldError struct {
+	Field    string
+	Messages []string
+}
+
+// Classify returns the supplied error of a Gitlab request as an *Error, using
+// the status code of the supplied response or of the error itself. Errors
+// without a status code, e.g. network errors, are returned unchanged.
+func Classify(res *gitlab.Response, err error) error {
+	if err == nil {
+		return nil
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		return err
+	}
+	var rl *RateLimitedError
+	if errors.As(err, &rl) {
+		return &Error{Kind: ErrorKindRateLimited, StatusCode: http.StatusTooManyRequests, err: err}
+	}
+
+	e = &Error{err: err}
+	var er *gitlab.ErrorResponse
+	if errors.As(err, &er) {
+		e.Fields = fieldErrors(er.Body)
+		if er.Response != nil {
+			e.StatusCode = er.Response.StatusCode
+		}
+	}
+	if res != nil && res.Response != nil {
+		e.StatusCode = res.StatusCode
+	}
+	if e.StatusCode == 0 {
+		return err
+	}
+	e.Kind = kindOf(e.StatusCode)
+	return e
+}
+
+func kindOf(code int) ErrorKind {
+	switch {
+	case code == http.StatusNotFound:
+		return ErrorKindNotFound
+	case code == http.StatusUnauthorized:
+		return ErrorKindUnauthorized
+	case code == http.StatusForbidden:
+		return ErrorKindForbidden
+	case code == http.StatusConflict:
+		return ErrorKindConflict
+	case code == http.StatusBadRequest, code == http.StatusUnprocessableEntity:
+		return ErrorKindValidation
+	case code == http.StatusTooManyRequests:
+		return ErrorKindRateLimited
+	case code >= http.StatusInternalServerError:
+		return ErrorKindServerError
+	default:
+		return ErrorKindOther
+	}
+}
+
+// fieldErrors returns the per field messages of a Gitlab error response body
+// like {"message": {"name": ["has already been taken"]}}, if any.
+func fieldErrors(body []byte) map[string][]string {
+	var r struct {
+		Message map[string]json.RawMessage `json:"message"`
+	}
+	if json.Unmarshal(body, &r) != nil || len(r.Message) == 0 {
+		return nil
+	}
+	fields := make(map[string][]string, len(r.Message))
+	for f, raw := range r.Message {
+		var msgs []string
+		if json.Unmarshal(raw, &msgs) == nil {
+			fields[f] = msgs
+			continue
+		}
+		var msg string
+		if json.Unmarshal(raw, &msg) == nil {
+			fields[f] = []string{msg}
+			continue
+		}
+		fields[f] = []string{string(raw)}
+	}
+	return fields
+}
+
+// KindOf returns the kind of the supplied error, or an empty ErrorKind if it
+// is nil or not a Gitlab API error.
+func KindOf(err error) ErrorKind {
+	var e *Error
+	if errors.As(Classify(nil, err), &e) {
+		return e.Kind
+	}
+	return ""
+}
+
+// IsNotFound returns true if the supplied error reports a missing resource.
+func IsNotFound(err error) bool {
+	return KindOf(err) == ErrorKindNotFound
+}
+
+// IsUnauthorized returns true if the supplied error reports invalid
+// credentials.
+func IsUnauthorized(err error) bool {
+	return KindOf(err) == ErrorKindUnauthorized
+}
+
+// IsForbidden returns true if the supplied error reports insufficient
+// permissions.
+func IsForbidden(err error) bool {
+	return KindOf(err) == ErrorKindForbidden
+}
+
+// IsConflict returns true if the supplied error reports a conflict with the
+// current state of a resource.
+func IsConflict(err error) bool {
+	return KindOf(err) == ErrorKindConflict
+}
+
+// IsValidation returns true if the supplied error reports an invalid request.
+func IsValidation(err error) bool {
+	return KindOf(err) == ErrorKindValidation
+}
+
+// IsServerError returns true if the supplied error reports a failure of
+// Gitlab itself.
+func IsServerError(err error) bool {
+	return KindOf(err) == ErrorKindServerError
+}
+
+// FieldErrors returns the per field validation messages of the supplied
+// error sorted by field, or nil if it has none.
+func FieldErrors(err error) []FieldError {
+	var e *Error
+	if !errors.As(Classify(nil, err), &e) {
+		return nil
+	}
+	return e.fieldErrors()
+}
+
+// GenerateLastError is used to produce common.LastError from an error
+// returned by Gitlab. It returns nil if there is no error.
+func GenerateLastError(err error) *common.LastError {
+	if err == nil {
+		return nil
+	}
+	le := &common.LastError{
+		Reason:  string(ErrorKindOther),
+		Message: err.Error(),
+		Time:    metav1.Now(),
+	}
+	if k := KindOf(err); k != "" {
+		le.Reason = string(k)
+	}
+	for _, f := range FieldErrors(err) {
+		le.FieldErrors = append(le.FieldErrors, common.FieldError{Field: f.Field, Messages: f.Messages})
+	}
+	return le
+}
+
+// APIError returns a condition that indicates the last request to Gitlab
+// failed with an error of the supplied kind.
+func APIError(kind ErrorKind, err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeAPIError,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             xpv1.ConditionReason(kind),
+		Message:            err.Error(),
+	}
+}
+
+// APISucceeded returns a condition that indicates the requests of the last
+// reconcile were accepted by Gitlab.
+func APISucceeded() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeAPIError,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonAPISucceeded,
+	}
+}
+
+// NewErrorConnecter wraps the supplied ExternalConnecter so that managed
+// resources whose reconcile failed with a Gitlab API error get a condition and
+// an event with the kind of the error as reason: rate limited resources the
+// RateLimited condition, all others the APIError condition. Creates and
+// updates that failed with an error that is not retryable are not sent again
+// for TerminalErrorBackoff unless the managed resource changes. The status
+// set by Create is written with the supplied client right away, because the
+// managed reconciler resets it when it persists the annotations of the
+// managed resource after Create.
+func NewErrorConnecter(c managed.ExternalConnecter, kube client.Client, r event.Recorder) managed.ExternalConnecter {
+	return &errorConnecter{ExternalConnecter: c, kube: kube, record: r, terminal: &terminalErrors{m: map[types.UID]terminalError{}}}
+}
+
+type errorConnecter struct {
+	managed.ExternalConnecter
+	kube     client.Client
+	record   event.Recorder
+	terminal *terminalErrors
+}
+
+func (c *errorConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &errorExternal{ExternalClient: e, kube: c.kube, record: c.record, terminal: c.terminal}, nil
+}
+
+// terminalErrors holds the last error that is not retryable of each managed
+// resource, together with the generation it was returned for.
+type terminalErrors struct {
+	sync.Mutex
+	m map[types.UID]terminalError
+}
+
+type terminalError struct {
+	generation int64
+	until      time.Time
+	err        error
+}
+
+// get returns the terminal error of the supplied managed resource, if it is
+// still current.
+func (t *terminalErrors) get(mg resource.Managed) error {
+	t.Lock()
+	defer t.Unlock()
+	te, ok := t.m[mg.GetUID()]
+	if !ok || te.generation != mg.GetGeneration() || time.Now().After(te.until) {
+		delete(t.m, mg.GetUID())
+		return nil
+	}
+	return te.err
+}
+
+// set records the supplied error of the supplied managed resource if it is
+// not retryable, and forgets any previous error otherwise.
+func (t *terminalErrors) set(mg resource.Managed, err error) {
+	t.Lock()
+	defer t.Unlock()
+	if k := KindOf(err); k == "" || k.Retryable() {
+		delete(t.m, mg.GetUID())
+		return
+	}
+	t.m[mg.GetUID()] = terminalError{generation: mg.GetGeneration(), until: time.Now().Add(TerminalErrorBackoff), err: err}
+}
+
+type errorExternal struct {
+	managed.ExternalClient
+	kube     client.Client
+	record   event.Recorder
+	terminal *terminalErrors
+}
+
+func (e *errorExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	if meta.WasDeleted(mg) {
+		// Orphaned resources are not deleted, but they are observed once
+		// more before they are gone.
+		e.forget(mg)
+	}
+	return o, e.observe(mg, err)
+}
+
+func (e *errorExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if err := e.terminal.get(mg); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	c, err := e.ExternalClient.Create(ctx, mg)
+	e.terminal.set(mg, err)
+	err = e.observe(mg, err)
+	e.persistStatus(ctx, mg)
+	return c, err
+}
+
+func (e *errorExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if err := e.terminal.get(mg); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	u, err := e.ExternalClient.Update(ctx, mg)
+	e.terminal.set(mg, err)
+	return u, e.observe(mg, err)
+}
+
+func (e *errorExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	err := e.ExternalClient.Delete(ctx, mg)
+	if err == nil {
+		e.forget(mg)
+	}
+	return e.observe(mg, err)
+}
+
+// forget drops everything remembered about the supplied managed resource,
+// which is about to be deleted.
+func (e *errorExternal) forget(mg resource.Managed) {
+	e.terminal.Lock()
+	delete(e.terminal.m, mg.GetUID())
+	e.terminal.Unlock()
+	forgetUnsupportedFields(mg)
+}
+
+// persistStatus writes the status of the supplied managed resource. The
+// managed reconciler updates the critical annotations of a managed resource
+// after Create, which replaces its status with the one stored in the API
+// server, so the last error and the conditions set by Create would be lost.
+// Only the status of a copy is written, the annotations set by Create, like
+// the external name, must stay in place until the reconciler persists them.
+// Failing to write the status is not an error of Create, the reconciler
+// writes the status itself at the end of the reconcile anyway.
+func (e *errorExternal) persistStatus(ctx context.Context, mg resource.Managed) {
+	st, ok := mg.DeepCopyObject().(resource.Managed)
+	if !ok || e.kube.Status().Update(ctx, st) != nil {
+		return
+	}
+	mg.SetResourceVersion(st.GetResourceVersion())
+}
+
+// observe sets the RateLimited and APIError conditions of the supplied
+// managed resource according to the supplied error, which it returns
+// unchanged. The conditions are only added to resources that had them set
+// before or that failed with a matching error. The managed reconciler emits
+// an event about every error, so an event is only emitted here once a
+// condition turns true or changes its reason.
+func (e *errorExternal) observe(mg resource.Managed, err error) error {
+	kind := KindOf(err)
+	switch {
+	case IsRateLimited(err):
+		e.setCondition(mg, RateLimited(err))
+	case err == nil && mg.GetCondition(TypeRateLimited).Status == corev1.ConditionTrue:
+		mg.SetConditions(NotRateLimited())
+	}
+	switch {
+	case kind != "" && kind != ErrorKindRateLimited:
+		e.setCondition(mg, APIError(kind, err))
+	case err == nil && mg.GetCondition(TypeAPIError).Status == corev1.ConditionTrue:
+		mg.SetConditions(APISucceeded())
+	}
+	return err
+}
+
+// setCondition sets the supplied condition, which is true, and emits a
+// warning event about it if it was not set with the same reason before.
+func (e *errorExternal) setCondition(mg resource.Managed, c xpv1.Condition) {
+	last := mg.GetCondition(c.Type)
+	mg.SetConditions(c)
+	if last.Status != corev1.ConditionTrue || last.Reason != c.Reason {
+		e.record.Event(mg, event.Warning(event.Reason(c.Reason), errors.New(c.Message)))
+	}
+}
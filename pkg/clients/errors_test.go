@@ -0,0 +1,338 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	gitlab "github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func errorResponse(code int, body string) *gitlab.ErrorResponse {
+	req, _ := http.NewRequest(http.MethodPost, "https://gitlab.com/api/v4/projects", nil)
+	return &gitlab.ErrorResponse{Body: []byte(body), Response: &http.Response{StatusCode: code, Request: req}}
+}
+
+func TestClassify(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		res  *gitlab.Response
+		err  error
+		want ErrorKind
+	}{
+		"NoError":      {},
+		"NetworkError": {err: errBoom},
+		"NotFound":     {err: errorResponse(http.StatusNotFound, `{"message":"404 Project Not Found"}`), want: ErrorKindNotFound},
+		"Unauthorized": {err: errorResponse(http.StatusUnauthorized, ""), want: ErrorKindUnauthorized},
+		"Forbidden":    {err: errorResponse(http.StatusForbidden, ""), want: ErrorKindForbidden},
+		"Conflict":     {err: errorResponse(http.StatusConflict, ""), want: ErrorKindConflict},
+		"BadRequest":   {err: errorResponse(http.StatusBadRequest, ""), want: ErrorKindValidation},
+		"Unprocessable": {
+			err:  errorResponse(http.StatusUnprocessableEntity, ""),
+			want: ErrorKindValidation,
+		},
+		"TooManyRequests": {err: errorResponse(http.StatusTooManyRequests, ""), want: ErrorKindRateLimited},
+		"RateLimited": {
+			err:  errors.Wrap(&RateLimitedError{Host: "gitlab.com", RetryAfter: time.Minute}, "cannot get project"),
+			want: ErrorKindRateLimited,
+		},
+		"ServerError": {err: errorResponse(http.StatusBadGateway, ""), want: ErrorKindServerError},
+		"Response": {
+			res:  &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}},
+			err:  errBoom,
+			want: ErrorKindNotFound,
+		},
+		"Wrapped": {err: errors.Wrap(errorResponse(http.StatusForbidden, ""), "cannot create project"), want: ErrorKindForbidden},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := Classify(tc.res, tc.err)
+			if diff := cmp.Diff(tc.want, KindOf(err)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if !errors.Is(err, tc.err) {
+				t.Errorf("Classify(...): want error wrapping %v, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestFieldErrors(t *testing.T) {
+	type want struct {
+		fields []FieldError
+		msg    string
+	}
+
+	cases := map[string]struct {
+		err  error
+		want want
+	}{
+		"Fields": {
+			err: errorResponse(http.StatusBadRequest, `{"message":{"path":["has already been taken"],"name":["can't be blank","is too short"]}}`),
+			want: want{
+				fields: []FieldError{
+					{Field: "name", Messages: []string{"can't be blank", "is too short"}},
+					{Field: "path", Messages: []string{"has already been taken"}},
+				},
+				msg: "Bad Request: name can't be blank; name is too short; path has already been taken",
+			},
+		},
+		"Message": {
+			err: errorResponse(http.StatusBadRequest, `{"message":"400 Bad request"}`),
+			want: want{
+				msg: "POST https://gitlab.com/api/v4/projects: 400 ",
+			},
+		},
+		"NotAnAPIError": {
+			err: errors.New("boom"),
+			want: want{
+				msg: "boom",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want.fields, FieldErrors(tc.err)); diff != "" {
+				t.Errorf("fields: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.msg, Classify(nil, tc.err).Error()); diff != "" {
+				t.Errorf("message: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateLastError(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want *common.LastError
+	}{
+		"NoError": {},
+		"Validation": {
+			err: Classify(nil, errorResponse(http.StatusBadRequest, `{"message":{"path":["has already been taken"]}}`)),
+			want: &common.LastError{
+				Reason:      string(ErrorKindValidation),
+				Message:     "Bad Request: path has already been taken",
+				FieldErrors: []common.FieldError{{Field: "path", Messages: []string{"has already been taken"}}},
+			},
+		},
+		"NotAnAPIError": {
+			err:  errors.New("boom"),
+			want: &common.LastError{Reason: string(ErrorKindOther), Message: "boom"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateLastError(tc.err)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreFields(common.LastError{}, "Time")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestErrorExternal(t *testing.T) {
+	errForbidden := errorResponse(http.StatusForbidden, "")
+	errServer := errorResponse(http.StatusInternalServerError, "")
+
+	type want struct {
+		status corev1.ConditionStatus
+		reason xpv1.ConditionReason
+		calls  int
+		events int
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		errs []error
+		want want
+	}{
+		"Forbidden": {
+			mg:   &v1alpha1.Project{},
+			errs: []error{errForbidden},
+			want: want{status: corev1.ConditionTrue, reason: xpv1.ConditionReason(ErrorKindForbidden), calls: 1, events: 1},
+		},
+		"ForbiddenNotRetried": {
+			mg:   &v1alpha1.Project{},
+			errs: []error{errForbidden, errForbidden},
+			want: want{status: corev1.ConditionTrue, reason: xpv1.ConditionReason(ErrorKindForbidden), calls: 1, events: 1},
+		},
+		"ServerErrorRetried": {
+			mg:   &v1alpha1.Project{},
+			errs: []error{errServer, errServer},
+			want: want{status: corev1.ConditionTrue, reason: xpv1.ConditionReason(ErrorKindServerError), calls: 2, events: 1},
+		},
+		"ReasonChanged": {
+			mg:   &v1alpha1.Project{},
+			errs: []error{errServer, errForbidden},
+			want: want{status: corev1.ConditionTrue, reason: xpv1.ConditionReason(ErrorKindForbidden), calls: 2, events: 2},
+		},
+		"Recovered": {
+			mg:   &v1alpha1.Project{},
+			errs: []error{errServer, nil},
+			want: want{status: corev1.ConditionFalse, reason: ReasonAPISucceeded, calls: 2, events: 1},
+		},
+		"OtherError": {
+			mg:   &v1alpha1.Project{},
+			errs: []error{errors.New("boom")},
+			want: want{status: corev1.ConditionUnknown, calls: 1},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			rec := &countingRecorder{}
+			e := &errorExternal{
+				ExternalClient: &managed.ExternalClientFns{
+					UpdateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+						err := tc.errs[calls]
+						calls++
+						return managed.ExternalUpdate{}, err
+					},
+				},
+				record:   rec,
+				terminal: &terminalErrors{m: map[types.UID]terminalError{}},
+			}
+			for i := range tc.errs {
+				_, err := e.Update(context.Background(), tc.mg)
+				if !errors.Is(err, tc.errs[i]) {
+					t.Errorf("Update %d: want %v, got %v", i, tc.errs[i], err)
+				}
+			}
+			got := want{status: tc.mg.GetCondition(TypeAPIError).Status, reason: tc.mg.GetCondition(TypeAPIError).Reason, calls: calls, events: rec.events}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestErrorExternalDeleteForgets(t *testing.T) {
+	mg := &v1alpha1.Project{}
+	mg.SetUID("deleted")
+	e := &errorExternal{
+		ExternalClient: &managed.ExternalClientFns{
+			DeleteFn: func(_ context.Context, _ resource.Managed) error { return nil },
+		},
+		record:   event.NewNopRecorder(),
+		terminal: &terminalErrors{m: map[types.UID]terminalError{}},
+	}
+	e.terminal.set(mg, errorResponse(http.StatusForbidden, ""))
+	RecordUnsupportedFields(event.NewNopRecorder(), mg, Instance{}, []string{"field"})
+
+	if err := e.Delete(context.Background(), mg); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(0, len(e.terminal.m)); diff != "" {
+		t.Errorf("terminal errors: -want, +got:\n%s", diff)
+	}
+	if _, ok := unsupportedFields.m[mg.GetUID()]; ok {
+		t.Errorf("Delete(...): want unsupported fields to be forgotten")
+	}
+}
+
+func TestErrorExternalCreatePersistsStatus(t *testing.T) {
+	errForbidden := errorResponse(http.StatusForbidden, "")
+
+	cases := map[string]struct {
+		err       error
+		statusErr error
+		want      corev1.ConditionStatus
+		wantRV    string
+	}{
+		"Failed": {
+			err:    errForbidden,
+			want:   corev1.ConditionTrue,
+			wantRV: "2",
+		},
+		"StatusUpdateFailed": {
+			err:       errForbidden,
+			statusErr: errors.New("boom"),
+			want:      corev1.ConditionTrue,
+			wantRV:    "1",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var persisted resource.Managed
+			kube := &test.MockClient{
+				MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+					if tc.statusErr != nil {
+						return tc.statusErr
+					}
+					persisted = obj.DeepCopyObject().(resource.Managed)
+					// The API server returns the annotations it stored.
+					obj.SetAnnotations(nil)
+					obj.SetResourceVersion("2")
+					return nil
+				},
+			}
+			e := &errorExternal{
+				ExternalClient: &managed.ExternalClientFns{
+					CreateFn: func(_ context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+						meta.SetExternalName(mg, "42")
+						return managed.ExternalCreation{}, tc.err
+					},
+				},
+				kube:     kube,
+				record:   event.NewNopRecorder(),
+				terminal: &terminalErrors{m: map[types.UID]terminalError{}},
+			}
+			mg := &v1alpha1.Project{}
+			mg.SetResourceVersion("1")
+			if _, err := e.Create(context.Background(), mg); !errors.Is(err, tc.err) {
+				t.Errorf("Create: want %v, got %v", tc.err, err)
+			}
+			if persisted != nil {
+				if diff := cmp.Diff(tc.want, persisted.GetCondition(TypeAPIError).Status); diff != "" {
+					t.Errorf("persisted: -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff("42", meta.GetExternalName(mg)); diff != "" {
+				t.Errorf("external name: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantRV, mg.GetResourceVersion()); diff != "" {
+				t.Errorf("resource version: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
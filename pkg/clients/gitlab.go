@@ -17,13 +17,13 @@ limitations under the License.
 package clients
 
 import (
+	"bytes"
 	"context"
-	"crypto/tls"
 	"net/http"
+	"os"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
-	"github.com/hashicorp/go-cleanhttp"
 	"github.com/pkg/errors"
 	gitlab "github.com/xanzy/go-gitlab"
 	corev1 "k8s.io/api/core/v1"
@@ -39,33 +39,57 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
 )
 
+// InjectedIdentityTokenEnv is the environment variable the provider reads its
+// token from when a ProviderConfig uses the InjectedIdentity credentials source.
+const InjectedIdentityTokenEnv = "GITLAB_TOKEN"
+
 // Config provides gitlab configurations for the Gitlab client
 type Config struct {
 	Token              string
 	BaseURL            string
 	InsecureSkipVerify bool
+	AuthMethod         v1beta1.AuthMethod
+	Username           string
+	OAuth              *OAuthConfig
+	CABundle           []byte
+	ClientCert         []byte
+	ClientKey          []byte
+	ProxyURL           string
+	NoProxy            string
+	ExtraHeaders       http.Header
+
+	// cacheUID and cacheKey identify the client built from this Config in
+	// the client cache. They are only set for Configs produced from a
+	// ProviderConfig.
+	cacheUID types.UID
+	cacheKey string
+
+	// providerConfig is the name of the ProviderConfig this Config was
+	// produced from, if any. It labels the metrics of the client.
+	providerConfig string
 }
 
 // NewClient creates new Gitlab Client with provided Gitlab Configurations/Credentials.
+// Clients of Configs produced by GetConfig are cached per ProviderConfig and
+// reused until the ProviderConfig or its credentials change.
 func NewClient(c Config) *gitlab.Client {
+	return cachedClientFor(c, newClient)
+}
+
+func newClient(c Config) *gitlab.Client {
 	options := []gitlab.ClientOptionFunc{}
 	if c.BaseURL != "" {
 		options = append(options, gitlab.WithBaseURL(c.BaseURL))
 	}
-	if c.InsecureSkipVerify {
-		transport := cleanhttp.DefaultPooledTransport()
-		if transport.TLSClientConfig == nil {
-			transport.TLSClientConfig = &tls.Config{
-				MinVersion: tls.VersionTLS12,
-			}
-		}
-		transport.TLSClientConfig.InsecureSkipVerify = true
-		httpclient := &http.Client{
-			Transport: transport,
-		}
-		options = append(options, gitlab.WithHTTPClient(httpclient))
+	transport, err := NewTransport(c)
+	if err != nil {
+		panic(err)
+	}
+	httpclient := &http.Client{
+		Transport: transport,
 	}
-	cl, err := gitlab.NewClient(c.Token, options...)
+	options = append(options, gitlab.WithHTTPClient(httpclient))
+	cl, err := newAuthenticatedClient(c, httpclient, options...)
 	if err != nil {
 		panic(err)
 	}
@@ -84,34 +108,112 @@ func GetConfig(ctx context.Context, c client.Client, mg resource.Managed) (*Conf
 }
 
 // UseProviderConfig to produce a config that can be used to authenticate to Gitlab.
+// Configs whose credentials are read from a Secret are cached until the
+// ProviderConfig or one of its Secrets changes.
 func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed) (*Config, error) {
+	name := mg.GetProviderConfigReference().Name
+	cached, epoch, ok := cachedConfigFor(name)
 	pc := &v1beta1.ProviderConfig{}
-	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
-		return nil, errors.Wrap(err, "cannot get referenced Provider")
+	if !ok {
+		if err := c.Get(ctx, types.NamespacedName{Name: name}, pc); err != nil {
+			return nil, errors.Wrap(err, "cannot get referenced Provider")
+		}
 	}
 
 	t := resource.NewProviderConfigUsageTracker(c, &v1beta1.ProviderConfigUsage{})
 	if err := t.Track(ctx, mg); err != nil {
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
+	if ok {
+		return cached, nil
+	}
 
-	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
+	r := &secretRecorder{Client: c}
+	cfg, err := ConfigFromProviderConfig(ctx, r, pc)
+	if err != nil {
+		return nil, err
+	}
+	// Credentials read from the filesystem or environment must be read again
+	// on every Connect to pick up rotated tokens.
+	if pc.Spec.Credentials.Source == xpv1.CredentialsSourceSecret {
+		cacheConfig(name, epoch, *cfg, r.secrets)
+	}
+	return cfg, nil
+}
+
+// ConfigFromProviderConfig produces a config that can be used to authenticate
+// to Gitlab from the supplied ProviderConfig, without tracking its usage.
+func ConfigFromProviderConfig(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig) (*Config, error) {
+	cd := pc.Spec.Credentials
+	if ref := pc.Status.RotatedCredentialsSecretRef; ref != nil && cd.Source == xpv1.CredentialsSourceSecret {
+		// The rotated access token could not be written into the
+		// credentials Secret yet.
+		cd.SecretRef = ref
+	}
+	token, secretVersion, err := extractCredentials(ctx, c, cd)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &Config{
+		BaseURL:            pc.Spec.BaseURL,
+		Token:              string(token),
+		InsecureSkipVerify: ptr.Deref(pc.Spec.InsecureSkipVerify, false),
+		AuthMethod:         ptr.Deref(pc.Spec.AuthMethod, v1beta1.AuthMethodPersonal),
+		Username:           ptr.Deref(pc.Spec.Username, ""),
+	}
+	if err := useAuthMethod(ctx, c, pc, cfg); err != nil {
+		return nil, err
+	}
+	if err := useTLS(ctx, c, pc, cfg); err != nil {
+		return nil, err
+	}
+	if err := useTransport(ctx, c, pc, cfg); err != nil {
+		return nil, err
+	}
+	cfg.providerConfig = pc.GetName()
+	cfg.cacheUID = pc.GetUID()
+	cfg.cacheKey = clientCacheKey(pc, secretVersion, *cfg)
+	return cfg, nil
+}
+
+// getSecretValue returns the value of the supplied key of a Secret.
+func getSecretValue(ctx context.Context, c client.Client, ref *xpv1.SecretKeySelector) ([]byte, error) {
+	s := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, err
+	}
+	return s.Data[ref.Key], nil
+}
+
+// extractCredentials reads the token from the configured credentials source
+// and returns it along with the resourceVersion of the Secret it was read
+// from, if any. The token is read again on every call, so a token mounted
+// from the filesystem or injected into the environment is picked up on the
+// next Connect after it was rotated.
+func extractCredentials(ctx context.Context, c client.Client, cd v1beta1.ProviderCredentials) ([]byte, string, error) {
+	switch s := cd.Source; s { //nolint:exhaustive
 	case xpv1.CredentialsSourceSecret:
-		csr := pc.Spec.Credentials.SecretRef
+		csr := cd.SecretRef
 		if csr == nil {
-			return nil, errors.New("no credentials secret referenced")
+			return nil, "", errors.New("no credentials secret referenced")
 		}
 		s := &corev1.Secret{}
 		if err := c.Get(ctx, types.NamespacedName{Namespace: csr.Namespace, Name: csr.Name}, s); err != nil {
-			return nil, errors.Wrap(err, "cannot get credentials secret")
+			return nil, "", errors.Wrap(err, "cannot get credentials secret")
+		}
+		return s.Data[csr.Key], s.GetResourceVersion(), nil
+	case xpv1.CredentialsSourceInjectedIdentity:
+		// GitLab has no workload identity federation, so an injected identity
+		// is a token placed into the provider's environment by the runtime.
+		token := os.Getenv(InjectedIdentityTokenEnv)
+		if token == "" {
+			return nil, "", errors.Errorf("environment variable %s is not set", InjectedIdentityTokenEnv)
 		}
-		return &Config{
-			BaseURL:            pc.Spec.BaseURL,
-			Token:              string(s.Data[csr.Key]),
-			InsecureSkipVerify: ptr.Deref(pc.Spec.InsecureSkipVerify, false),
-		}, nil
+		return []byte(token), "", nil
 	default:
-		return nil, errors.Errorf("credentials source %s is not currently supported", s)
+		token, err := resource.CommonCredentialExtractor(ctx, s, c, cd.CommonCredentialSelectors)
+		return bytes.TrimSpace(token), "", errors.Wrapf(err, "cannot extract credentials from source %s", s)
 	}
 }
 
@@ -0,0 +1,358 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "github.com/xanzy/go-gitlab"
+	"golang.org/x/oauth2"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+)
+
+func TestExtractCredentials(t *testing.T) {
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "token")
+	if err := os.WriteFile(tokenFile, []byte("fs-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("TEST_GITLAB_TOKEN", "env-token")
+	t.Setenv(InjectedIdentityTokenEnv, "injected-token")
+
+	type want struct {
+		token string
+		err   error
+	}
+
+	cases := map[string]struct {
+		kube client.Client
+		cd   v1beta1.ProviderCredentials
+		want want
+	}{
+		"Secret": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					obj.(*corev1.Secret).Data = map[string][]byte{"token": []byte("secret-token")}
+					return nil
+				},
+			},
+			cd: v1beta1.ProviderCredentials{
+				Source: xpv1.CredentialsSourceSecret,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+					SecretRef: &xpv1.SecretKeySelector{Key: "token"},
+				},
+			},
+			want: want{token: "secret-token"},
+		},
+		"SecretRefMissing": {
+			cd: v1beta1.ProviderCredentials{Source: xpv1.CredentialsSourceSecret},
+			want: want{
+				err: errors.New("no credentials secret referenced"),
+			},
+		},
+		"Environment": {
+			cd: v1beta1.ProviderCredentials{
+				Source: xpv1.CredentialsSourceEnvironment,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+					Env: &xpv1.EnvSelector{Name: "TEST_GITLAB_TOKEN"},
+				},
+			},
+			want: want{token: "env-token"},
+		},
+		"Filesystem": {
+			cd: v1beta1.ProviderCredentials{
+				Source: xpv1.CredentialsSourceFilesystem,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+					Fs: &xpv1.FsSelector{Path: tokenFile},
+				},
+			},
+			want: want{token: "fs-token"},
+		},
+		"InjectedIdentity": {
+			cd:   v1beta1.ProviderCredentials{Source: xpv1.CredentialsSourceInjectedIdentity},
+			want: want{token: "injected-token"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			token, _, err := extractCredentials(context.Background(), tc.kube, tc.cd)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.token, string(token)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOAuthTokenURL(t *testing.T) {
+	cases := map[string]struct {
+		baseURL string
+		want    string
+	}{
+		"Default":     {baseURL: "", want: "https://gitlab.com/oauth/token"},
+		"Host":        {baseURL: "https://git.example.com", want: "https://git.example.com/oauth/token"},
+		"APIPath":     {baseURL: "https://git.example.com/api/v4/", want: "https://git.example.com/oauth/token"},
+		"SubPathHost": {baseURL: "https://example.com/gitlab/api/v4", want: "https://example.com/gitlab/oauth/token"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, oauthTokenURL(tc.baseURL)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewAuthenticatedClient(t *testing.T) {
+	type want struct {
+		header string
+		value  string
+	}
+
+	cases := map[string]struct {
+		cfg  func(url string) Config
+		want want
+	}{
+		"Personal": {
+			cfg: func(_ string) Config {
+				return Config{Token: "pat", AuthMethod: v1beta1.AuthMethodPersonal}
+			},
+			want: want{header: "PRIVATE-TOKEN", value: "pat"},
+		},
+		"Job": {
+			cfg: func(_ string) Config {
+				return Config{Token: "job", AuthMethod: v1beta1.AuthMethodJob}
+			},
+			want: want{header: "JOB-TOKEN", value: "job"},
+		},
+		"OAuthStatic": {
+			cfg: func(_ string) Config {
+				return Config{Token: "access", AuthMethod: v1beta1.AuthMethodOAuth}
+			},
+			want: want{header: "Authorization", value: "Bearer access"},
+		},
+		"OAuthClientCredentials": {
+			cfg: func(url string) Config {
+				return Config{
+					Token:      "app-secret",
+					AuthMethod: v1beta1.AuthMethodOAuth,
+					OAuth: &OAuthConfig{
+						GrantType: v1beta1.OAuthGrantClientCredentials,
+						ClientID:  "app",
+						TokenURL:  url + "/oauth/token",
+					},
+				}
+			},
+			want: want{header: "Authorization", value: "Bearer issued"},
+		},
+		"OAuthRefreshToken": {
+			cfg: func(url string) Config {
+				return Config{
+					Token:      "refresh",
+					AuthMethod: v1beta1.AuthMethodOAuth,
+					OAuth: &OAuthConfig{
+						GrantType:    v1beta1.OAuthGrantRefreshToken,
+						ClientID:     "app",
+						ClientSecret: "app-secret",
+						TokenURL:     url + "/oauth/token",
+					},
+				}
+			},
+			want: want{header: "Authorization", value: "Bearer issued"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got http.Header
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/oauth/token" {
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = w.Write([]byte(`{"access_token":"issued","token_type":"bearer","expires_in":7200}`))
+					return
+				}
+				got = r.Header.Clone()
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"id":1}`))
+			}))
+			defer srv.Close()
+
+			cfg := tc.cfg(srv.URL)
+			cl, err := newAuthenticatedClient(cfg, srv.Client(), gitlab.WithBaseURL(srv.URL), gitlab.WithHTTPClient(srv.Client()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, _, err := cl.Users.CurrentUser(); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.value, got.Get(tc.want.header)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOAuthTokenSource(t *testing.T) {
+	issued := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		issued++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"access_token":"access-%d","refresh_token":"refresh-%d","token_type":"bearer","expires_in":1}`, issued, issued)
+	}))
+	defer srv.Close()
+
+	var written []string
+	var writeErr error
+	cfg := Config{
+		Token:      "refresh-0",
+		AuthMethod: v1beta1.AuthMethodOAuth,
+		OAuth: &OAuthConfig{
+			GrantType: v1beta1.OAuthGrantRefreshToken,
+			ClientID:  "app",
+			TokenURL:  srv.URL,
+			writeRefreshToken: func(token string) error {
+				if writeErr != nil {
+					return writeErr
+				}
+				written = append(written, token)
+				return nil
+			},
+		},
+		cacheUID: "token-source-test",
+	}
+	source := func(cfg Config) oauth2.TokenSource {
+		ts, err := oauthTokenSource(cfg, srv.Client())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ts
+	}
+
+	ts := source(cfg)
+	if _, err := ts.Token(); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"refresh-1"}, written); diff != "" {
+		t.Errorf("written: -want, +got:\n%s", diff)
+	}
+
+	// A failed write is not retried before RefreshTokenRetryInterval passed.
+	writeErr = errors.New("boom")
+	if _, err := ts.Token(); err != nil {
+		t.Fatal(err)
+	}
+	writeErr = nil
+	if _, err := ts.Token(); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"refresh-1"}, written); diff != "" {
+		t.Errorf("written: -want, +got:\n%s", diff)
+	}
+	pts := ts.(*persistingTokenSource)
+	pts.failed = pts.failed.Add(-RefreshTokenRetryInterval)
+	if _, err := ts.Token(); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"refresh-1", "refresh-4"}, written); diff != "" {
+		t.Errorf("written: -want, +got:\n%s", diff)
+	}
+
+	cached := tokenSources.m[string(cfg.cacheUID)]
+	cases := map[string]struct {
+		cfg  func(Config) Config
+		same bool
+	}{
+		"WrittenToken": {
+			cfg:  func(c Config) Config { c.Token = "refresh-4"; return c },
+			same: true,
+		},
+		"StaleToken": {
+			cfg:  func(c Config) Config { c.Token = "refresh-1"; return c },
+			same: true,
+		},
+		"ReplacedToken": {
+			cfg:  func(c Config) Config { c.Token = "other"; return c },
+			same: false,
+		},
+		"ChangedSettings": {
+			cfg: func(c Config) Config {
+				o := *c.OAuth
+				o.ClientID = "other"
+				c.OAuth = &o
+				c.Token = "refresh-4"
+				return c
+			},
+			same: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.same, source(tc.cfg(cfg)) == ts); diff != "" {
+				t.Errorf("same token source: -want, +got:\n%s", diff)
+			}
+			tokenSources.m[string(cfg.cacheUID)] = cached
+		})
+	}
+}
+
+func TestConfigFromProviderConfigRotatedCredentials(t *testing.T) {
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			obj.(*corev1.Secret).Data = map[string][]byte{"token": []byte(key.Name)}
+			return nil
+		},
+	}
+	pc := &v1beta1.ProviderConfig{
+		Spec: v1beta1.ProviderConfigSpec{Credentials: v1beta1.ProviderCredentials{
+			Source: xpv1.CredentialsSourceSecret,
+			CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: &xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "gitlab"},
+				Key:             "token",
+			}},
+		}},
+		Status: v1beta1.ProviderConfigStatus{RotatedCredentialsSecretRef: &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "gitlab-rotated-x"},
+			Key:             "token",
+		}},
+	}
+
+	cfg, err := ConfigFromProviderConfig(context.Background(), kube, pc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff("gitlab-rotated-x", cfg.Token); diff != "" {
+		t.Errorf("token: -want, +got:\n%s", diff)
+	}
+}
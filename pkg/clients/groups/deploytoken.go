@@ -17,8 +17,6 @@ limitations under the License.
 package groups
 
 import (
-	"strings"
-
 	"github.com/xanzy/go-gitlab"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
@@ -32,12 +30,12 @@ type DeployTokenClient interface {
 	DeleteGroupDeployToken(gid interface{}, deployToken int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 }
 
-// IsErrorGroupDeployTokenNotFound helper function to test for errGroupDeployTokenNotFound error.
+// IsErrorGroupDeployTokenNotFound returns true if the supplied error reports a missing group deploy token.
+//
+// Deprecated: Use clients.IsNotFound, which does not depend on the error
+// message.
 func IsErrorGroupDeployTokenNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	return strings.Contains(err.Error(), errGroupNotFound)
+	return clients.IsNotFound(err)
 }
 
 // NewDeployTokenClient returns a new Gitlab GroupDeployToken service
@@ -34,6 +34,7 @@ type MockClient struct {
 	MockDeleteGroup           func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 	MockShareGroupWithGroup   func(gid interface{}, opt *gitlab.ShareGroupWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error)
 	MockUnshareGroupFromGroup func(gid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockTransferSubGroup      func(gid interface{}, opt *gitlab.TransferSubGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error)
 
 	MockGetMember    func(gid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMember, *gitlab.Response, error)
 	MockAddMember    func(gid interface{}, opt *gitlab.AddGroupMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMember, *gitlab.Response, error)
@@ -83,6 +84,11 @@ func (c *MockClient) UnshareGroupFromGroup(gid interface{}, groupID int, options
 	return c.MockUnshareGroupFromGroup(gid, groupID, options...)
 }
 
+// TransferSubGroup calls the underlying MockTransferSubGroup method
+func (c *MockClient) TransferSubGroup(gid interface{}, opt *gitlab.TransferSubGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+	return c.MockTransferSubGroup(gid, opt, options...)
+}
+
 // GetGroupMember calls the underlying MockGetMember method.
 func (c *MockClient) GetGroupMember(gid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMember, *gitlab.Response, error) {
 	return c.MockGetMember(gid, user)
@@ -17,7 +17,6 @@ limitations under the License.
 package groups
 
 import (
-	"strings"
 	"time"
 
 	"github.com/xanzy/go-gitlab"
@@ -27,10 +26,6 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 )
 
-const (
-	errGroupNotFound = "404 Group Not Found"
-)
-
 // Client defines Gitlab Group service operations
 type Client interface {
 	GetGroup(gid interface{}, opt *gitlab.GetGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error)
@@ -39,6 +34,7 @@ type Client interface {
 	DeleteGroup(gid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 	ShareGroupWithGroup(gid interface{}, opt *gitlab.ShareGroupWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error)
 	UnshareGroupFromGroup(gid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	TransferSubGroup(gid interface{}, opt *gitlab.TransferSubGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error)
 }
 
 // NewGroupClient returns a new Gitlab Group service
@@ -47,12 +43,12 @@ func NewGroupClient(cfg clients.Config) Client {
 	return git.Groups
 }
 
-// IsErrorGroupNotFound helper function to test for errGroupNotFound error.
+// IsErrorGroupNotFound returns true if the supplied error reports a missing group.
+//
+// Deprecated: Use clients.IsNotFound, which does not depend on the error
+// message.
 func IsErrorGroupNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	return strings.Contains(err.Error(), errGroupNotFound)
+	return clients.IsNotFound(err)
 }
 
 // VisibilityValueV1alpha1ToGitlab converts *v1alpha1.VisibilityValue to *gitlab.VisibilityValue
@@ -82,6 +78,7 @@ func GenerateObservation(grp *gitlab.Group) v1alpha1.GroupObservation { // nolin
 		WebURL:    &grp.WebURL,
 		FullName:  &grp.FullName,
 		FullPath:  &grp.FullPath,
+		ParentID:  &grp.ParentID,
 		LDAPCN:    &grp.LDAPCN,
 	}
 
@@ -195,3 +192,23 @@ func GenerateEditGroupOptions(name string, p *v1alpha1.GroupParameters) *gitlab.
 	}
 	return group
 }
+
+// SupportedParameters returns the supplied parameters without the fields the
+// supplied Gitlab instance cannot honor, and the names of the removed fields
+// that were set. The supplied parameters are not modified.
+func SupportedParameters(p *v1alpha1.GroupParameters, i clients.Instance) (*v1alpha1.GroupParameters, []string) {
+	if i.SupportsPremiumFeatures() {
+		return p, nil
+	}
+	sp := p.DeepCopy()
+	var unsupported []string
+	if sp.SharedRunnersMinutesLimit != nil {
+		unsupported = append(unsupported, "sharedRunnersMinutesLimit")
+		sp.SharedRunnersMinutesLimit = nil
+	}
+	if sp.ExtraSharedRunnersMinutesLimit != nil {
+		unsupported = append(unsupported, "extraSharedRunnersMinutesLimit")
+		sp.ExtraSharedRunnersMinutesLimit = nil
+	}
+	return sp, unsupported
+}
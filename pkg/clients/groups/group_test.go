@@ -25,6 +25,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 )
 
 var (
@@ -113,6 +114,7 @@ func TestGenerateObservation(t *testing.T) {
 					WebURL:     webURL,
 					FullName:   fullName,
 					FullPath:   fullPath,
+					ParentID:   parentID,
 					Statistics: &gitlabStatistics,
 					CustomAttributes: []*gitlab.CustomAttribute{
 						{
@@ -153,6 +155,7 @@ func TestGenerateObservation(t *testing.T) {
 				WebURL:     &webURL,
 				FullName:   &fullName,
 				FullPath:   &fullPath,
+				ParentID:   &parentID,
 				Statistics: &v1alpha1Statistics,
 				CustomAttributes: []v1alpha1.CustomAttribute{
 					{
@@ -204,6 +207,7 @@ func TestGenerateObservation(t *testing.T) {
 				WebURL:    &s,
 				FullName:  &s,
 				FullPath:  &s,
+				ParentID:  &i,
 				LDAPCN:    &s,
 
 				SharedWithGroups: []v1alpha1.SharedWithGroupsObservation{{
@@ -393,3 +397,42 @@ func TestGenerateEditGroupOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestSupportedParameters(t *testing.T) {
+	limit := 100
+	p := &v1alpha1.GroupParameters{Path: path, SharedRunnersMinutesLimit: &limit, ExtraSharedRunnersMinutesLimit: &limit}
+
+	type want struct {
+		params      *v1alpha1.GroupParameters
+		unsupported []string
+	}
+
+	cases := map[string]struct {
+		instance clients.Instance
+		want     want
+	}{
+		"EnterpriseEdition": {
+			instance: clients.Instance{Edition: clients.EditionEE},
+			want:     want{params: p},
+		},
+		"CommunityEdition": {
+			instance: clients.Instance{Edition: clients.EditionCE},
+			want: want{
+				params:      &v1alpha1.GroupParameters{Path: path},
+				unsupported: []string{"sharedRunnersMinutesLimit", "extraSharedRunnersMinutesLimit"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			params, unsupported := SupportedParameters(p, tc.instance)
+			if diff := cmp.Diff(tc.want.params, params); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.unsupported, unsupported); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,18 +17,12 @@ limitations under the License.
 package groups
 
 import (
-	"strings"
-
 	"github.com/xanzy/go-gitlab"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 )
 
-const (
-	errMemberNotFound = "404 Group Member Not Found"
-)
-
 // MemberClient defines Gitlab Member service operations
 type MemberClient interface {
 	GetGroupMember(gid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMember, *gitlab.Response, error)
@@ -43,12 +37,12 @@ func NewMemberClient(cfg clients.Config) MemberClient {
 	return git.GroupMembers
 }
 
-// IsErrorMemberNotFound helper function to test for errMemberNotFound error.
+// IsErrorMemberNotFound returns true if the supplied error reports a missing group member.
+//
+// Deprecated: Use clients.IsNotFound, which does not depend on the error
+// message.
 func IsErrorMemberNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	return strings.Contains(err.Error(), errMemberNotFound)
+	return clients.IsNotFound(err)
 }
 
 // GenerateMemberObservation is used to produce v1alpha1.MemberObservation from
@@ -17,8 +17,6 @@ limitations under the License.
 package groups
 
 import (
-	"strings"
-
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -28,10 +26,6 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 )
 
-const (
-	errVariableNotFound = "404 Variable Not Found"
-)
-
 // VariableClient defines Gitlab Variable service operations
 type VariableClient interface {
 	ListVariables(gid interface{}, opt *gitlab.ListGroupVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupVariable, *gitlab.Response, error)
@@ -47,12 +41,12 @@ func NewVariableClient(cfg clients.Config) VariableClient {
 	return git.GroupVariables
 }
 
-// IsErrorVariableNotFound helper function to test for errGroupNotFound error.
+// IsErrorVariableNotFound returns true if the supplied error reports a missing group variable.
+//
+// Deprecated: Use clients.IsNotFound, which does not depend on the error
+// message.
 func IsErrorVariableNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	return strings.Contains(err.Error(), errVariableNotFound)
+	return clients.IsNotFound(err)
 }
 
 // LateInitializeVariable fills the empty fields in the groupVariable spec with the
@@ -0,0 +1,78 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"net/http"
+
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// HealthClient defines the Gitlab operations used to check and rotate the
+// credentials of a ProviderConfig.
+type HealthClient interface {
+	CurrentUser(options ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error)
+	GetSinglePersonalAccessToken(options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error)
+	GetVersion(options ...gitlab.RequestOptionFunc) (*gitlab.Version, *gitlab.Response, error)
+	RotateSelfAccessToken(opt *RotateSelfAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error)
+}
+
+// RotateSelfAccessTokenOptions represents the available RotateSelfAccessToken
+// options.
+type RotateSelfAccessTokenOptions struct {
+	ExpiresAt *gitlab.ISOTime `url:"expires_at,omitempty" json:"expires_at,omitempty"`
+}
+
+// NewHealthClient returns a new Gitlab client to check and rotate the
+// credentials of a ProviderConfig.
+func NewHealthClient(cfg Config) HealthClient {
+	git := NewClient(cfg)
+	return &healthClient{
+		UsersService:                git.Users,
+		PersonalAccessTokensService: git.PersonalAccessTokens,
+		VersionService:              git.Version,
+		git:                         git,
+	}
+}
+
+type healthClient struct {
+	*gitlab.UsersService
+	*gitlab.PersonalAccessTokensService
+	*gitlab.VersionService
+	git *gitlab.Client
+}
+
+// RotateSelfAccessToken rotates the access token the client authenticates
+// with. The token is revoked and the returned token replaces it. go-gitlab
+// has no support for this endpoint yet.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html
+func (c *healthClient) RotateSelfAccessToken(opt *RotateSelfAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+	req, err := c.git.NewRequest(http.MethodPost, "personal_access_tokens/self/rotate", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pat := new(gitlab.PersonalAccessToken)
+	resp, err := c.git.Do(req, pat)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pat, resp, nil
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	gitlab "github.com/xanzy/go-gitlab"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+)
+
+const (
+	errUnsupportedField = "spec.forProvider.%s is not supported by %s and is ignored"
+
+	// ReasonUnsupportedField is the reason of events about fields the Gitlab
+	// instance cannot honor.
+	ReasonUnsupportedField event.Reason = "UnsupportedField"
+)
+
+// An Edition of Gitlab.
+type Edition string
+
+// Gitlab editions. EditionUnknown is used if the edition could not be
+// detected.
+const (
+	EditionUnknown Edition = ""
+	EditionCE      Edition = "ce"
+	EditionEE      Edition = "ee"
+)
+
+// A Tier of Gitlab, i.e. the features its license unlocks.
+type Tier string
+
+// Gitlab tiers. TierPremium covers Premium and Ultimate, as well as their
+// legacy names. TierUnknown is used if the tier could not be detected.
+const (
+	TierUnknown Tier = ""
+	TierFree    Tier = "free"
+	TierPremium Tier = "premium"
+)
+
+// An Instance describes the Gitlab instance a Config connects to.
+type Instance struct {
+	Version string
+	Edition Edition
+	Tier    Tier
+}
+
+// SupportsPremiumFeatures returns false if the instance is known to be a Free
+// tier instance, which ignores Premium and Ultimate settings. Community
+// Edition instances are always Free. Instances of unknown edition or tier are
+// assumed to support them.
+func (i Instance) SupportsPremiumFeatures() bool {
+	return i.Edition != EditionCE && i.Tier != TierFree
+}
+
+// detected returns true if both the edition and the tier of the instance are
+// known.
+func (i Instance) detected() bool {
+	return i.Edition != EditionUnknown && i.Tier != TierUnknown
+}
+
+func (i Instance) String() string {
+	if i.Edition == EditionUnknown {
+		return "Gitlab"
+	}
+	s := "Gitlab " + strings.ToUpper(string(i.Edition))
+	if i.Edition == EditionEE && i.Tier == TierFree {
+		s += " Free"
+	}
+	return s + " " + i.Version
+}
+
+// MetadataClient defines Gitlab Metadata service operations
+type MetadataClient interface {
+	GetMetadata(options ...gitlab.RequestOptionFunc) (*gitlab.Metadata, *gitlab.Response, error)
+}
+
+// VersionClient defines Gitlab Version service operations
+type VersionClient interface {
+	GetVersion(options ...gitlab.RequestOptionFunc) (*gitlab.Version, *gitlab.Response, error)
+}
+
+// LicenseClient defines Gitlab License service operations
+type LicenseClient interface {
+	GetLicense(options ...gitlab.RequestOptionFunc) (*gitlab.License, *gitlab.Response, error)
+}
+
+// UnknownInstanceTTL is how long an instance whose edition or tier could not
+// be detected is cached before its detection is attempted again.
+var UnknownInstanceTTL = 5 * time.Minute
+
+// instances caches the detected Gitlab instance per ProviderConfig and base
+// URL.
+var instances = &instanceCache{m: map[string]cachedInstance{}}
+
+type instanceCache struct {
+	sync.Mutex
+	m map[string]cachedInstance
+}
+
+// cachedInstance is a detected instance. Instances of unknown edition or tier
+// expire, all others are kept.
+type cachedInstance struct {
+	instance Instance
+	expires  time.Time
+}
+
+// get returns the cached instance of the supplied key, or the instance
+// returned by detect, which it caches.
+func (c *instanceCache) get(key string, detect func() Instance) Instance {
+	c.Lock()
+	ci, ok := c.m[key]
+	c.Unlock()
+	if ok && (ci.expires.IsZero() || time.Now().Before(ci.expires)) {
+		return ci.instance
+	}
+	i := detect()
+	ci = cachedInstance{instance: i}
+	if !i.detected() {
+		ci.expires = time.Now().Add(UnknownInstanceTTL)
+	}
+	c.Lock()
+	c.m[key] = ci
+	c.Unlock()
+	return i
+}
+
+// GetInstance returns the version, edition and tier of the Gitlab instance
+// the supplied Config connects to. They are only detected on the first call
+// for each ProviderConfig, or again after UnknownInstanceTTL if the edition or
+// tier could not be detected.
+func GetInstance(c Config) Instance {
+	detect := func() Instance {
+		cl := NewClient(c)
+		return DetectInstance(cl.Metadata, cl.Version, cl.License)
+	}
+	if c.cacheUID == "" {
+		return detect()
+	}
+	return instances.get(string(c.cacheUID)+"|"+c.BaseURL, detect)
+}
+
+// DetectInstance returns the version and edition reported by the metadata API
+// of a Gitlab instance, which is available since Gitlab 15.2. Older instances
+// are detected by their version, which ends with -ee for the Enterprise
+// Edition. The edition is unknown if neither API can be queried.
+//
+// The Enterprise Edition runs without a license on the Free tier, so the tier
+// of an Enterprise Edition instance is detected from its license. The license
+// can only be read with an administrator token; instances whose license
+// cannot be read or is missing or expired are treated as Free, like the
+// Community Edition.
+func DetectInstance(md MetadataClient, v VersionClient, l LicenseClient) Instance {
+	var i Instance
+	if m, _, err := md.GetMetadata(); err == nil && m != nil {
+		i = instanceOf(m.Version, m.Enterprise)
+	} else if ver, _, err := v.GetVersion(); err == nil && ver != nil && ver.Version != "" {
+		i = instanceOf(ver.Version, false)
+	} else {
+		return Instance{}
+	}
+	if i.Edition == EditionEE {
+		i.Tier = tierOf(l)
+	}
+	return i
+}
+
+func instanceOf(version string, enterprise bool) Instance {
+	i := Instance{Version: version, Edition: EditionCE, Tier: TierFree}
+	if enterprise || strings.HasSuffix(version, "-ee") {
+		i.Edition = EditionEE
+		i.Tier = TierUnknown
+	}
+	return i
+}
+
+// tierOf returns the tier unlocked by the license of an Enterprise Edition
+// instance.
+func tierOf(l LicenseClient) Tier {
+	lic, res, err := l.GetLicense()
+	if err != nil {
+		err = Classify(res, err)
+		if IsNotFound(err) || IsForbidden(err) {
+			return TierFree
+		}
+		return TierUnknown
+	}
+	if lic == nil || lic.Plan == "" || lic.Expired {
+		return TierFree
+	}
+	return TierPremium
+}
+
+// unsupportedFields holds the unsupported fields that were last recorded for
+// each object, together with the generation they were recorded for.
+var unsupportedFields = struct {
+	sync.Mutex
+	m map[types.UID]recordedFields
+}{m: map[types.UID]recordedFields{}}
+
+type recordedFields struct {
+	generation int64
+	fields     string
+}
+
+// RecordUnsupportedFields emits a warning event about each of the supplied
+// spec.forProvider fields of the supplied object, which the supplied Gitlab
+// instance cannot honor. The events are only emitted again once the fields
+// or the generation of the object change, not on every poll.
+func RecordUnsupportedFields(r event.Recorder, o client.Object, i Instance, fields []string) {
+	rf := recordedFields{generation: o.GetGeneration(), fields: strings.Join(fields, ",")}
+	unsupportedFields.Lock()
+	last, ok := unsupportedFields.m[o.GetUID()]
+	if len(fields) == 0 {
+		delete(unsupportedFields.m, o.GetUID())
+	} else {
+		unsupportedFields.m[o.GetUID()] = rf
+	}
+	unsupportedFields.Unlock()
+	if ok && last == rf {
+		return
+	}
+	for _, f := range fields {
+		r.Event(o, event.Warning(ReasonUnsupportedField, errors.Errorf(errUnsupportedField, f, i)))
+	}
+}
+
+// forgetUnsupportedFields drops the unsupported fields recorded for the
+// supplied object.
+func forgetUnsupportedFields(o client.Object) {
+	unsupportedFields.Lock()
+	defer unsupportedFields.Unlock()
+	delete(unsupportedFields.m, o.GetUID())
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "github.com/xanzy/go-gitlab"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+type metadataClientFn func() (*gitlab.Metadata, *gitlab.Response, error)
+
+func (fn metadataClientFn) GetMetadata(_ ...gitlab.RequestOptionFunc) (*gitlab.Metadata, *gitlab.Response, error) {
+	return fn()
+}
+
+type versionClientFn func() (*gitlab.Version, *gitlab.Response, error)
+
+func (fn versionClientFn) GetVersion(_ ...gitlab.RequestOptionFunc) (*gitlab.Version, *gitlab.Response, error) {
+	return fn()
+}
+
+type licenseClientFn func() (*gitlab.License, *gitlab.Response, error)
+
+func (fn licenseClientFn) GetLicense(_ ...gitlab.RequestOptionFunc) (*gitlab.License, *gitlab.Response, error) {
+	return fn()
+}
+
+func TestDetectInstance(t *testing.T) {
+	errNotFound := errors.New("404 Not Found")
+	premium := &gitlab.License{Plan: "premium"}
+
+	cases := map[string]struct {
+		md     *gitlab.Metadata
+		mdErr  error
+		ver    *gitlab.Version
+		verErr error
+		lic    *gitlab.License
+		licErr error
+		want   Instance
+	}{
+		"Enterprise": {
+			md:   &gitlab.Metadata{Version: "16.5.0-pre", Enterprise: true},
+			lic:  premium,
+			want: Instance{Version: "16.5.0-pre", Edition: EditionEE, Tier: TierPremium},
+		},
+		"EnterpriseVersionSuffix": {
+			md:   &gitlab.Metadata{Version: "15.3.0-ee"},
+			lic:  premium,
+			want: Instance{Version: "15.3.0-ee", Edition: EditionEE, Tier: TierPremium},
+		},
+		"EnterpriseUnlicensed": {
+			md:   &gitlab.Metadata{Version: "16.5.0-ee", Enterprise: true},
+			lic:  &gitlab.License{},
+			want: Instance{Version: "16.5.0-ee", Edition: EditionEE, Tier: TierFree},
+		},
+		"EnterpriseLicenseExpired": {
+			md:   &gitlab.Metadata{Version: "16.5.0-ee", Enterprise: true},
+			lic:  &gitlab.License{Plan: "ultimate", Expired: true},
+			want: Instance{Version: "16.5.0-ee", Edition: EditionEE, Tier: TierFree},
+		},
+		"EnterpriseLicenseNotFound": {
+			md:     &gitlab.Metadata{Version: "16.5.0-ee", Enterprise: true},
+			licErr: errorResponse(http.StatusNotFound, ""),
+			want:   Instance{Version: "16.5.0-ee", Edition: EditionEE, Tier: TierFree},
+		},
+		"EnterpriseLicenseForbidden": {
+			md:     &gitlab.Metadata{Version: "16.5.0-ee", Enterprise: true},
+			licErr: errorResponse(http.StatusForbidden, ""),
+			want:   Instance{Version: "16.5.0-ee", Edition: EditionEE, Tier: TierFree},
+		},
+		"EnterpriseLicenseUnavailable": {
+			md:     &gitlab.Metadata{Version: "16.5.0-ee", Enterprise: true},
+			licErr: errors.New("connection refused"),
+			want:   Instance{Version: "16.5.0-ee", Edition: EditionEE},
+		},
+		"Community": {
+			md:   &gitlab.Metadata{Version: "16.5.0"},
+			want: Instance{Version: "16.5.0", Edition: EditionCE, Tier: TierFree},
+		},
+		"VersionEnterprise": {
+			mdErr: errNotFound,
+			ver:   &gitlab.Version{Version: "14.10.5-ee"},
+			lic:   premium,
+			want:  Instance{Version: "14.10.5-ee", Edition: EditionEE, Tier: TierPremium},
+		},
+		"VersionCommunity": {
+			mdErr: errNotFound,
+			ver:   &gitlab.Version{Version: "14.10.5"},
+			want:  Instance{Version: "14.10.5", Edition: EditionCE, Tier: TierFree},
+		},
+		"Unavailable": {
+			mdErr:  errNotFound,
+			verErr: errNotFound,
+			want:   Instance{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DetectInstance(
+				metadataClientFn(func() (*gitlab.Metadata, *gitlab.Response, error) { return tc.md, nil, tc.mdErr }),
+				versionClientFn(func() (*gitlab.Version, *gitlab.Response, error) { return tc.ver, nil, tc.verErr }),
+				licenseClientFn(func() (*gitlab.License, *gitlab.Response, error) { return tc.lic, nil, tc.licErr }),
+			)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInstanceCache(t *testing.T) {
+	ce := Instance{Version: "16.5.0", Edition: EditionCE, Tier: TierFree}
+
+	cases := map[string]struct {
+		cached map[string]cachedInstance
+		want   Instance
+		calls  int
+	}{
+		"Detected": {
+			want:  ce,
+			calls: 1,
+		},
+		"Cached": {
+			cached: map[string]cachedInstance{"pc": {instance: Instance{Edition: EditionEE}}},
+			want:   Instance{Edition: EditionEE},
+		},
+		"UnknownCached": {
+			cached: map[string]cachedInstance{"pc": {expires: time.Now().Add(time.Minute)}},
+			want:   Instance{},
+		},
+		"UnknownTierCached": {
+			cached: map[string]cachedInstance{"pc": {instance: Instance{Edition: EditionEE}, expires: time.Now().Add(time.Minute)}},
+			want:   Instance{Edition: EditionEE},
+		},
+		"UnknownTierExpired": {
+			cached: map[string]cachedInstance{"pc": {instance: Instance{Edition: EditionEE}, expires: time.Now().Add(-time.Minute)}},
+			want:   ce,
+			calls:  1,
+		},
+		"UnknownExpired": {
+			cached: map[string]cachedInstance{"pc": {expires: time.Now().Add(-time.Minute)}},
+			want:   ce,
+			calls:  1,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &instanceCache{m: map[string]cachedInstance{}}
+			for k, v := range tc.cached {
+				c.m[k] = v
+			}
+			calls := 0
+			got := c.get("pc", func() Instance {
+				calls++
+				return ce
+			})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.calls, calls); diff != "" {
+				t.Errorf("calls: -want, +got:\n%s", diff)
+			}
+		})
+	}
+
+	// Unknown instances are cached until they expire.
+	c := &instanceCache{m: map[string]cachedInstance{}}
+	calls := 0
+	for i := 0; i < 2; i++ {
+		c.get("pc", func() Instance {
+			calls++
+			return Instance{}
+		})
+	}
+	if calls != 1 {
+		t.Errorf("calls: want 1, got %d", calls)
+	}
+}
+
+type countingRecorder struct {
+	events int
+}
+
+func (r *countingRecorder) Event(_ runtime.Object, _ event.Event) { r.events++ }
+
+func (r *countingRecorder) WithAnnotations(_ ...string) event.Recorder { return r }
+
+func TestRecordUnsupportedFields(t *testing.T) {
+	type poll struct {
+		generation int64
+		fields     []string
+	}
+
+	cases := map[string]struct {
+		polls []poll
+		want  int
+	}{
+		"OncePerGeneration": {
+			polls: []poll{{1, []string{"approvalsBeforeMerge"}}, {1, []string{"approvalsBeforeMerge"}}, {2, []string{"approvalsBeforeMerge"}}},
+			want:  2,
+		},
+		"FieldsChanged": {
+			polls: []poll{{1, []string{"approvalsBeforeMerge"}}, {1, []string{"approvalsBeforeMerge", "mirror"}}},
+			want:  3,
+		},
+		"FieldsRemovedAndAddedAgain": {
+			polls: []poll{{1, []string{"approvalsBeforeMerge"}}, {1, nil}, {1, []string{"approvalsBeforeMerge"}}},
+			want:  2,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &countingRecorder{}
+			o := &v1alpha1.Project{}
+			o.SetUID(types.UID(name))
+			for _, p := range tc.polls {
+				o.SetGeneration(p.generation)
+				RecordUnsupportedFields(r, o, Instance{Edition: EditionCE}, p.fields)
+			}
+			if diff := cmp.Diff(tc.want, r.events); diff != "" {
+				t.Errorf("events: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
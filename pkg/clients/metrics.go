@@ -0,0 +1,110 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+)
+
+// apiSegments are the path segments of the Gitlab API endpoints the provider
+// uses. All other segments are IDs, paths or names.
+var apiSegments = map[string]bool{
+	"access_tokens":          true,
+	"all":                    true,
+	"approval_rules":         true,
+	"approvals":              true,
+	"archive":                true,
+	"branches":               true,
+	"deploy_keys":            true,
+	"deploy_tokens":          true,
+	"enable":                 true,
+	"groups":                 true,
+	"hooks":                  true,
+	"members":                true,
+	"metadata":               true,
+	"namespaces":             true,
+	"personal_access_tokens": true,
+	"pipeline_schedules":     true,
+	"play":                   true,
+	"projects":               true,
+	"protected_branches":     true,
+	"protected_tags":         true,
+	"push_rule":              true,
+	"repository":             true,
+	"restore":                true,
+	"rotate":                 true,
+	"self":                   true,
+	"share":                  true,
+	"subgroups":              true,
+	"tags":                   true,
+	"take_ownership":         true,
+	"transfer":               true,
+	"unarchive":              true,
+	"user":                   true,
+	"users":                  true,
+	"variables":              true,
+	"version":                true,
+}
+
+// namedSegments are path segments whose successor is a name rather than a
+// numeric ID, e.g. the key of a variable or the name of a branch.
+var namedSegments = map[string]bool{
+	"branches":           true,
+	"protected_branches": true,
+	"protected_tags":     true,
+	"tags":               true,
+	"variables":          true,
+}
+
+// metricsTransport records the count and latency of requests to Gitlab.
+type metricsTransport struct {
+	base           http.RoundTripper
+	providerConfig string
+}
+
+func (t *metricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	res, err := t.base.RoundTrip(req)
+	code := "error"
+	if err == nil {
+		code = strconv.Itoa(res.StatusCode)
+	}
+	metrics.ObserveAPIRequest(t.providerConfig, req.Method, endpointTemplate(req.URL.EscapedPath()), code, time.Since(start))
+	return res, err
+}
+
+// endpointTemplate returns the API endpoint of the supplied request path with
+// all segments but the known API segments replaced by placeholders, e.g.
+// /projects/:id/hooks/:id for /api/v4/projects/42/hooks/7. This keeps the
+// cardinality of the metrics bounded.
+func endpointTemplate(path string) string {
+	if i := strings.Index(path, "/"+apiVersionPath); i >= 0 {
+		path = path[i+len(apiVersionPath)+1:]
+	}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, s := range segments {
+		if (i > 0 && namedSegments[segments[i-1]]) || !apiSegments[s] {
+			segments[i] = ":id"
+		}
+	}
+	return "/" + strings.Join(segments, "/")
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEndpointTemplate(t *testing.T) {
+	cases := map[string]struct {
+		path string
+		want string
+	}{
+		"Collection": {
+			path: "/api/v4/projects",
+			want: "/projects",
+		},
+		"NumericID": {
+			path: "/api/v4/projects/42/hooks/7",
+			want: "/projects/:id/hooks/:id",
+		},
+		"EncodedPath": {
+			path: "/api/v4/groups/parent%2Fchild/variables",
+			want: "/groups/:id/variables",
+		},
+		"NamedSegment": {
+			path: "/api/v4/projects/42/variables/my_key",
+			want: "/projects/:id/variables/:id",
+		},
+		"SubPathHost": {
+			path: "/gitlab/api/v4/user",
+			want: "/user",
+		},
+		"Name": {
+			path: "/api/v4/projects/my_project/hooks",
+			want: "/projects/:id/hooks",
+		},
+		"UnknownEndpoint": {
+			path: "/api/v4/projects/42/some_endpoint",
+			want: "/projects/:id/:id",
+		},
+		"NamedAPISegment": {
+			path: "/api/v4/projects/42/variables/projects",
+			want: "/projects/:id/variables/:id",
+		},
+		"SelfToken": {
+			path: "/api/v4/personal_access_tokens/self/rotate",
+			want: "/personal_access_tokens/self/rotate",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, endpointTemplate(tc.path)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
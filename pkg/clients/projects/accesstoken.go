@@ -17,8 +17,6 @@ limitations under the License.
 package projects
 
 import (
-	"strings"
-
 	"github.com/xanzy/go-gitlab"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
@@ -32,12 +30,12 @@ type AccessTokenClient interface {
 	RevokeProjectAccessToken(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 }
 
-// IsErrorProjectAccessTokenNotFound helper function to test for errProjectAccessTokenNotFound error.
+// IsErrorProjectAccessTokenNotFound returns true if the supplied error reports a missing project access token.
+//
+// Deprecated: Use clients.IsNotFound, which does not depend on the error
+// message.
 func IsErrorProjectAccessTokenNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	return strings.Contains(err.Error(), errProjectNotFound)
+	return clients.IsNotFound(err)
 }
 
 // NewAccessTokenClient returns a new Gitlab ProjectAccessToken service
@@ -0,0 +1,198 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// ApprovalRuleClient defines Gitlab project approval rule service operations
+type ApprovalRuleClient interface {
+	GetProjectApprovalRule(pid interface{}, ruleID int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error)
+	CreateProjectApprovalRule(pid interface{}, opt *gitlab.CreateProjectLevelRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error)
+	UpdateProjectApprovalRule(pid interface{}, approvalRule int, opt *gitlab.UpdateProjectLevelRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error)
+	DeleteProjectApprovalRule(pid interface{}, approvalRule int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewApprovalRuleClient returns a new Gitlab project approval rule service
+func NewApprovalRuleClient(cfg clients.Config) ApprovalRuleClient {
+	git := clients.NewClient(cfg)
+	return git.Projects
+}
+
+// GenerateApprovalRuleObservation is used to produce
+// v1alpha1.ApprovalRuleObservation from gitlab.ProjectApprovalRule.
+func GenerateApprovalRuleObservation(r *gitlab.ProjectApprovalRule) v1alpha1.ApprovalRuleObservation {
+	if r == nil {
+		return v1alpha1.ApprovalRuleObservation{}
+	}
+
+	o := v1alpha1.ApprovalRuleObservation{
+		ID:                   &r.ID,
+		ContainsHiddenGroups: r.ContainsHiddenGroups,
+	}
+	for _, u := range r.EligibleApprovers {
+		o.EligibleApproverIDs = append(o.EligibleApproverIDs, u.ID)
+	}
+	return o
+}
+
+// LateInitializeApprovalRule fills the empty fields in the approval rule spec
+// with the values seen in gitlab.ProjectApprovalRule.
+func LateInitializeApprovalRule(in *v1alpha1.ApprovalRuleParameters, r *gitlab.ProjectApprovalRule) {
+	if r == nil {
+		return
+	}
+
+	in.RuleType = clients.LateInitializeStringPtr(in.RuleType, r.RuleType)
+	if in.AppliesToAllProtectedBranches == nil {
+		in.AppliesToAllProtectedBranches = &r.AppliesToAllProtectedBranches
+	}
+}
+
+// GenerateCreateApprovalRuleOptions generates approval rule creation options
+func GenerateCreateApprovalRuleOptions(p *v1alpha1.ApprovalRuleParameters) *gitlab.CreateProjectLevelRuleOptions {
+	return &gitlab.CreateProjectLevelRuleOptions{
+		Name:                          &p.Name,
+		ApprovalsRequired:             &p.ApprovalsRequired,
+		RuleType:                      p.RuleType,
+		UserIDs:                       approvalRuleUserIDs(p),
+		GroupIDs:                      approvalRuleGroupIDs(p),
+		ProtectedBranchIDs:            approvalRuleProtectedBranchIDs(p),
+		AppliesToAllProtectedBranches: p.AppliesToAllProtectedBranches,
+	}
+}
+
+// GenerateUpdateApprovalRuleOptions generates approval rule update options.
+// The eligible users and groups and the protected branches are always sent,
+// so that the ones missing from the spec are removed from the rule.
+func GenerateUpdateApprovalRuleOptions(p *v1alpha1.ApprovalRuleParameters) *gitlab.UpdateProjectLevelRuleOptions {
+	return &gitlab.UpdateProjectLevelRuleOptions{
+		Name:                          &p.Name,
+		ApprovalsRequired:             &p.ApprovalsRequired,
+		UserIDs:                       approvalRuleUserIDs(p),
+		GroupIDs:                      approvalRuleGroupIDs(p),
+		ProtectedBranchIDs:            approvalRuleProtectedBranchIDs(p),
+		AppliesToAllProtectedBranches: p.AppliesToAllProtectedBranches,
+	}
+}
+
+// IsApprovalRuleUpToDate checks whether there is a change in any of the
+// modifiable fields. The rule type cannot be changed and is ignored. Gitlab
+// does not return the groups of a rule the provider cannot see, so only the
+// visible groups are compared if the rule contains hidden groups.
+func IsApprovalRuleUpToDate(p *v1alpha1.ApprovalRuleParameters, r *gitlab.ProjectApprovalRule) bool {
+	if p.Name != r.Name || p.ApprovalsRequired != r.ApprovalsRequired {
+		return false
+	}
+	if !clients.IsBoolEqualToBoolPtr(p.AppliesToAllProtectedBranches, r.AppliesToAllProtectedBranches) {
+		return false
+	}
+
+	users := make([]int, 0, len(r.Users))
+	for _, u := range r.Users {
+		users = append(users, u.ID)
+	}
+	groups := make([]int, 0, len(r.Groups))
+	for _, g := range r.Groups {
+		groups = append(groups, g.ID)
+	}
+	branches := make([]int, 0, len(r.ProtectedBranches))
+	for _, b := range r.ProtectedBranches {
+		branches = append(branches, b.ID)
+	}
+
+	sameGroups := sameIDs(*approvalRuleGroupIDs(p), groups)
+	if r.ContainsHiddenGroups {
+		sameGroups = containsIDs(*approvalRuleGroupIDs(p), groups)
+	}
+
+	return sameIDs(*approvalRuleUserIDs(p), users) &&
+		sameGroups &&
+		sameIDs(*approvalRuleProtectedBranchIDs(p), branches)
+}
+
+func approvalRuleUserIDs(p *v1alpha1.ApprovalRuleParameters) *[]int {
+	ids := append([]int{}, p.UserIDs...)
+	return &ids
+}
+
+func approvalRuleGroupIDs(p *v1alpha1.ApprovalRuleParameters) *[]int {
+	ids := []int{}
+	for _, g := range p.Groups {
+		ids = appendID(ids, g.GroupID)
+	}
+	return &ids
+}
+
+func approvalRuleProtectedBranchIDs(p *v1alpha1.ApprovalRuleParameters) *[]int {
+	ids := []int{}
+	for _, b := range p.ProtectedBranches {
+		ids = appendID(ids, b.ProtectedBranchID)
+	}
+	return &ids
+}
+
+// appendID appends the supplied ID to ids. IDs whose reference is not
+// resolved yet are skipped; the CRD only admits numeric IDs.
+func appendID(ids []int, id *string) []int {
+	if id == nil {
+		return ids
+	}
+	i, err := strconv.Atoi(*id)
+	if err != nil {
+		return ids
+	}
+	return append(ids, i)
+}
+
+// sameIDs reports whether a and b contain the same IDs, regardless of order.
+func sameIDs(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]int{}, a...)
+	b = append([]int{}, b...)
+	sort.Ints(a)
+	sort.Ints(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// containsIDs reports whether a contains all IDs of b.
+func containsIDs(a, b []int) bool {
+	ids := make(map[int]bool, len(a))
+	for _, id := range a {
+		ids[id] = true
+	}
+	for _, id := range b {
+		if !ids[id] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func approvalRule() *gitlab.ProjectApprovalRule {
+	return &gitlab.ProjectApprovalRule{
+		ID:                   7,
+		Name:                 "release",
+		RuleType:             "regular",
+		ApprovalsRequired:    2,
+		EligibleApprovers:    []*gitlab.BasicUser{{ID: 1}, {ID: 2}, {ID: 3}},
+		Users:                []*gitlab.BasicUser{{ID: 2}, {ID: 1}},
+		Groups:               []*gitlab.Group{{ID: 10}},
+		ProtectedBranches:    []*gitlab.ProtectedBranch{{ID: 20}},
+		ContainsHiddenGroups: true,
+	}
+}
+
+func approvalRuleParameters() *v1alpha1.ApprovalRuleParameters {
+	return &v1alpha1.ApprovalRuleParameters{
+		Name:                          "release",
+		ApprovalsRequired:             2,
+		RuleType:                      ptr.To("regular"),
+		UserIDs:                       []int{1, 2},
+		Groups:                        []v1alpha1.ApprovalRuleGroup{{GroupID: ptr.To("10")}},
+		ProtectedBranches:             []v1alpha1.ApprovalRuleProtectedBranch{{ProtectedBranchID: ptr.To("20")}},
+		AppliesToAllProtectedBranches: ptr.To(false),
+	}
+}
+
+func TestGenerateApprovalRuleObservation(t *testing.T) {
+	cases := map[string]struct {
+		rule *gitlab.ProjectApprovalRule
+		want v1alpha1.ApprovalRuleObservation
+	}{
+		"Full": {
+			rule: approvalRule(),
+			want: v1alpha1.ApprovalRuleObservation{
+				ID:                   ptr.To(7),
+				EligibleApproverIDs:  []int{1, 2, 3},
+				ContainsHiddenGroups: true,
+			},
+		},
+		"Nil": {
+			want: v1alpha1.ApprovalRuleObservation{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateApprovalRuleObservation(tc.rule)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeApprovalRule(t *testing.T) {
+	cases := map[string]struct {
+		parameters *v1alpha1.ApprovalRuleParameters
+		rule       *gitlab.ProjectApprovalRule
+		want       *v1alpha1.ApprovalRuleParameters
+	}{
+		"AllOptionalFields": {
+			parameters: &v1alpha1.ApprovalRuleParameters{},
+			rule:       &gitlab.ProjectApprovalRule{RuleType: "any_approver", AppliesToAllProtectedBranches: true},
+			want: &v1alpha1.ApprovalRuleParameters{
+				RuleType:                      ptr.To("any_approver"),
+				AppliesToAllProtectedBranches: ptr.To(true),
+			},
+		},
+		"SomeFieldsDontOverwrite": {
+			parameters: &v1alpha1.ApprovalRuleParameters{RuleType: ptr.To("regular")},
+			rule:       &gitlab.ProjectApprovalRule{RuleType: "any_approver"},
+			want: &v1alpha1.ApprovalRuleParameters{
+				RuleType:                      ptr.To("regular"),
+				AppliesToAllProtectedBranches: ptr.To(false),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeApprovalRule(tc.parameters, tc.rule)
+			if diff := cmp.Diff(tc.want, tc.parameters); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateApprovalRuleOptions(t *testing.T) {
+	p := approvalRuleParameters()
+	// Groups whose reference is not resolved yet are skipped.
+	p.Groups = append(p.Groups, v1alpha1.ApprovalRuleGroup{})
+
+	wantCreate := &gitlab.CreateProjectLevelRuleOptions{
+		Name:                          ptr.To("release"),
+		ApprovalsRequired:             ptr.To(2),
+		RuleType:                      ptr.To("regular"),
+		UserIDs:                       &[]int{1, 2},
+		GroupIDs:                      &[]int{10},
+		ProtectedBranchIDs:            &[]int{20},
+		AppliesToAllProtectedBranches: ptr.To(false),
+	}
+	if diff := cmp.Diff(wantCreate, GenerateCreateApprovalRuleOptions(p)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+
+	wantUpdate := &gitlab.UpdateProjectLevelRuleOptions{
+		Name:               ptr.To("release"),
+		ApprovalsRequired:  ptr.To(0),
+		UserIDs:            &[]int{},
+		GroupIDs:           &[]int{},
+		ProtectedBranchIDs: &[]int{},
+	}
+	got := GenerateUpdateApprovalRuleOptions(&v1alpha1.ApprovalRuleParameters{Name: "release"})
+	if diff := cmp.Diff(wantUpdate, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsApprovalRuleUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    func(*v1alpha1.ApprovalRuleParameters)
+		r    func(*gitlab.ProjectApprovalRule)
+		want bool
+	}{
+		"UpToDate": {
+			p:    func(p *v1alpha1.ApprovalRuleParameters) {},
+			want: true,
+		},
+		"RuleTypeIgnored": {
+			p:    func(p *v1alpha1.ApprovalRuleParameters) { p.RuleType = ptr.To("any_approver") },
+			want: true,
+		},
+		"UsersInOtherOrder": {
+			p:    func(p *v1alpha1.ApprovalRuleParameters) { p.UserIDs = []int{2, 1} },
+			want: true,
+		},
+		"Name": {
+			p:    func(p *v1alpha1.ApprovalRuleParameters) { p.Name = "hotfix" },
+			want: false,
+		},
+		"ApprovalsRequired": {
+			p:    func(p *v1alpha1.ApprovalRuleParameters) { p.ApprovalsRequired = 1 },
+			want: false,
+		},
+		"UserRemoved": {
+			p:    func(p *v1alpha1.ApprovalRuleParameters) { p.UserIDs = []int{1} },
+			want: false,
+		},
+		"GroupsCleared": {
+			p:    func(p *v1alpha1.ApprovalRuleParameters) { p.Groups = nil },
+			want: false,
+		},
+		"HiddenGroup": {
+			p: func(p *v1alpha1.ApprovalRuleParameters) {
+				p.Groups = append(p.Groups, v1alpha1.ApprovalRuleGroup{GroupID: ptr.To("11")})
+			},
+			want: true,
+		},
+		"GroupAddedWithoutHiddenGroups": {
+			p: func(p *v1alpha1.ApprovalRuleParameters) {
+				p.Groups = append(p.Groups, v1alpha1.ApprovalRuleGroup{GroupID: ptr.To("11")})
+			},
+			r:    func(r *gitlab.ProjectApprovalRule) { r.ContainsHiddenGroups = false },
+			want: false,
+		},
+		"UpToDateWithoutHiddenGroups": {
+			p:    func(p *v1alpha1.ApprovalRuleParameters) {},
+			r:    func(r *gitlab.ProjectApprovalRule) { r.ContainsHiddenGroups = false },
+			want: true,
+		},
+		"ProtectedBranchChanged": {
+			p: func(p *v1alpha1.ApprovalRuleParameters) {
+				p.ProtectedBranches = []v1alpha1.ApprovalRuleProtectedBranch{{ProtectedBranchID: ptr.To("21")}}
+			},
+			want: false,
+		},
+		"AppliesToAllProtectedBranches": {
+			p:    func(p *v1alpha1.ApprovalRuleParameters) { p.AppliesToAllProtectedBranches = ptr.To(true) },
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := approvalRuleParameters()
+			tc.p(p)
+			r := approvalRule()
+			if tc.r != nil {
+				tc.r(r)
+			}
+			if diff := cmp.Diff(tc.want, IsApprovalRuleUpToDate(p, r)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
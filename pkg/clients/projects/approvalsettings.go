@@ -0,0 +1,89 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// ApprovalSettingsClient defines Gitlab project approval configuration
+// service operations
+type ApprovalSettingsClient interface {
+	GetApprovalConfiguration(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error)
+	ChangeApprovalConfiguration(pid interface{}, opt *gitlab.ChangeApprovalConfigurationOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error)
+}
+
+// NewApprovalSettingsClient returns a new Gitlab project approval
+// configuration service
+func NewApprovalSettingsClient(cfg clients.Config) ApprovalSettingsClient {
+	git := clients.NewClient(cfg)
+	return git.Projects
+}
+
+// LateInitializeApprovalSettings fills the empty fields in the approval
+// settings spec with the values seen in gitlab.ProjectApprovals.
+func LateInitializeApprovalSettings(in *v1alpha1.ApprovalSettingsParameters, a *gitlab.ProjectApprovals) {
+	if a == nil {
+		return
+	}
+
+	if in.ResetApprovalsOnPush == nil {
+		in.ResetApprovalsOnPush = &a.ResetApprovalsOnPush
+	}
+	if in.SelectiveCodeOwnerRemovals == nil {
+		in.SelectiveCodeOwnerRemovals = &a.SelectiveCodeOwnerRemovals
+	}
+	if in.MergeRequestsAuthorApproval == nil {
+		in.MergeRequestsAuthorApproval = &a.MergeRequestsAuthorApproval
+	}
+	if in.MergeRequestsDisableCommittersApproval == nil {
+		in.MergeRequestsDisableCommittersApproval = &a.MergeRequestsDisableCommittersApproval
+	}
+	if in.RequirePasswordToApprove == nil {
+		in.RequirePasswordToApprove = &a.RequirePasswordToApprove
+	}
+	if in.DisableOverridingApproversPerMergeRequest == nil {
+		in.DisableOverridingApproversPerMergeRequest = &a.DisableOverridingApproversPerMergeRequest
+	}
+}
+
+// GenerateChangeApprovalConfigurationOptions generates approval
+// configuration update options
+func GenerateChangeApprovalConfigurationOptions(p *v1alpha1.ApprovalSettingsParameters) *gitlab.ChangeApprovalConfigurationOptions {
+	return &gitlab.ChangeApprovalConfigurationOptions{
+		DisableOverridingApproversPerMergeRequest: p.DisableOverridingApproversPerMergeRequest,
+		MergeRequestsAuthorApproval:               p.MergeRequestsAuthorApproval,
+		MergeRequestsDisableCommittersApproval:    p.MergeRequestsDisableCommittersApproval,
+		RequirePasswordToApprove:                  p.RequirePasswordToApprove,
+		ResetApprovalsOnPush:                      p.ResetApprovalsOnPush,
+		SelectiveCodeOwnerRemovals:                p.SelectiveCodeOwnerRemovals,
+	}
+}
+
+// IsApprovalSettingsUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsApprovalSettingsUpToDate(p *v1alpha1.ApprovalSettingsParameters, a *gitlab.ProjectApprovals) bool {
+	return clients.IsBoolEqualToBoolPtr(p.ResetApprovalsOnPush, a.ResetApprovalsOnPush) &&
+		clients.IsBoolEqualToBoolPtr(p.SelectiveCodeOwnerRemovals, a.SelectiveCodeOwnerRemovals) &&
+		clients.IsBoolEqualToBoolPtr(p.MergeRequestsAuthorApproval, a.MergeRequestsAuthorApproval) &&
+		clients.IsBoolEqualToBoolPtr(p.MergeRequestsDisableCommittersApproval, a.MergeRequestsDisableCommittersApproval) &&
+		clients.IsBoolEqualToBoolPtr(p.RequirePasswordToApprove, a.RequirePasswordToApprove) &&
+		clients.IsBoolEqualToBoolPtr(p.DisableOverridingApproversPerMergeRequest, a.DisableOverridingApproversPerMergeRequest)
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestLateInitializeApprovalSettings(t *testing.T) {
+	cases := map[string]struct {
+		parameters *v1alpha1.ApprovalSettingsParameters
+		settings   *gitlab.ProjectApprovals
+		want       *v1alpha1.ApprovalSettingsParameters
+	}{
+		"AllOptionalFields": {
+			parameters: &v1alpha1.ApprovalSettingsParameters{},
+			settings: &gitlab.ProjectApprovals{
+				ResetApprovalsOnPush:        true,
+				MergeRequestsAuthorApproval: true,
+			},
+			want: &v1alpha1.ApprovalSettingsParameters{
+				ResetApprovalsOnPush:                      ptr.To(true),
+				SelectiveCodeOwnerRemovals:                ptr.To(false),
+				MergeRequestsAuthorApproval:               ptr.To(true),
+				MergeRequestsDisableCommittersApproval:    ptr.To(false),
+				RequirePasswordToApprove:                  ptr.To(false),
+				DisableOverridingApproversPerMergeRequest: ptr.To(false),
+			},
+		},
+		"SomeFieldsDontOverwrite": {
+			parameters: &v1alpha1.ApprovalSettingsParameters{
+				ResetApprovalsOnPush:        ptr.To(false),
+				MergeRequestsAuthorApproval: ptr.To(false),
+			},
+			settings: &gitlab.ProjectApprovals{
+				ResetApprovalsOnPush:        true,
+				MergeRequestsAuthorApproval: true,
+				RequirePasswordToApprove:    true,
+			},
+			want: &v1alpha1.ApprovalSettingsParameters{
+				ResetApprovalsOnPush:                      ptr.To(false),
+				SelectiveCodeOwnerRemovals:                ptr.To(false),
+				MergeRequestsAuthorApproval:               ptr.To(false),
+				MergeRequestsDisableCommittersApproval:    ptr.To(false),
+				RequirePasswordToApprove:                  ptr.To(true),
+				DisableOverridingApproversPerMergeRequest: ptr.To(false),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeApprovalSettings(tc.parameters, tc.settings)
+			if diff := cmp.Diff(tc.want, tc.parameters); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsApprovalSettingsUpToDate(t *testing.T) {
+	settings := &gitlab.ProjectApprovals{
+		ResetApprovalsOnPush:                   true,
+		MergeRequestsDisableCommittersApproval: true,
+	}
+
+	cases := map[string]struct {
+		p    *v1alpha1.ApprovalSettingsParameters
+		want bool
+	}{
+		"Unset":                                  {p: &v1alpha1.ApprovalSettingsParameters{}, want: true},
+		"UpToDate":                               {p: &v1alpha1.ApprovalSettingsParameters{ResetApprovalsOnPush: ptr.To(true), RequirePasswordToApprove: ptr.To(false)}, want: true},
+		"ResetApprovalsOnPush":                   {p: &v1alpha1.ApprovalSettingsParameters{ResetApprovalsOnPush: ptr.To(false)}, want: false},
+		"SelectiveCodeOwnerRemovals":             {p: &v1alpha1.ApprovalSettingsParameters{SelectiveCodeOwnerRemovals: ptr.To(true)}, want: false},
+		"MergeRequestsAuthorApproval":            {p: &v1alpha1.ApprovalSettingsParameters{MergeRequestsAuthorApproval: ptr.To(true)}, want: false},
+		"MergeRequestsDisableCommittersApproval": {p: &v1alpha1.ApprovalSettingsParameters{MergeRequestsDisableCommittersApproval: ptr.To(false)}, want: false},
+		"RequirePasswordToApprove":               {p: &v1alpha1.ApprovalSettingsParameters{RequirePasswordToApprove: ptr.To(true)}, want: false},
+		"DisableOverridingApprovers":             {p: &v1alpha1.ApprovalSettingsParameters{DisableOverridingApproversPerMergeRequest: ptr.To(true)}, want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsApprovalSettingsUpToDate(tc.p, settings)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
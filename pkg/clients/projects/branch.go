@@ -0,0 +1,64 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// BranchClient defines Gitlab branch service operations
+type BranchClient interface {
+	GetBranch(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error)
+	CreateBranch(pid interface{}, opt *gitlab.CreateBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error)
+	DeleteBranch(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewBranchClient returns a new Gitlab branch service
+func NewBranchClient(cfg clients.Config) BranchClient {
+	git := clients.NewClient(cfg)
+	return git.Branches
+}
+
+// GenerateBranchObservation is used to produce v1alpha1.BranchObservation
+// from gitlab.Branch.
+func GenerateBranchObservation(b *gitlab.Branch) v1alpha1.BranchObservation {
+	if b == nil {
+		return v1alpha1.BranchObservation{}
+	}
+
+	o := v1alpha1.BranchObservation{
+		Protected: b.Protected,
+		Default:   b.Default,
+		Merged:    b.Merged,
+		WebURL:    b.WebURL,
+	}
+	if b.Commit != nil {
+		o.CommitSHA = b.Commit.ID
+	}
+	return o
+}
+
+// GenerateCreateBranchOptions generates branch creation options
+func GenerateCreateBranchOptions(p *v1alpha1.BranchParameters) *gitlab.CreateBranchOptions {
+	return &gitlab.CreateBranchOptions{
+		Branch: &p.Name,
+		Ref:    &p.Ref,
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestGenerateBranchObservation(t *testing.T) {
+	cases := map[string]struct {
+		branch *gitlab.Branch
+		want   v1alpha1.BranchObservation
+	}{
+		"Full": {
+			branch: &gitlab.Branch{
+				Commit:    &gitlab.Commit{ID: "7b5c3cc8be40ee161ae89a06bba6229da1032a0c"},
+				Name:      "develop",
+				Protected: true,
+				Default:   true,
+				WebURL:    "https://gitlab.example.com/group/project/-/tree/develop",
+			},
+			want: v1alpha1.BranchObservation{
+				CommitSHA: "7b5c3cc8be40ee161ae89a06bba6229da1032a0c",
+				Protected: true,
+				Default:   true,
+				WebURL:    "https://gitlab.example.com/group/project/-/tree/develop",
+			},
+		},
+		"NoCommit": {
+			branch: &gitlab.Branch{Name: "develop", Merged: true},
+			want:   v1alpha1.BranchObservation{Merged: true},
+		},
+		"Nil": {
+			want: v1alpha1.BranchObservation{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateBranchObservation(tc.branch)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateCreateBranchOptions(t *testing.T) {
+	p := &v1alpha1.BranchParameters{Name: "release/1.0", Ref: "main"}
+	want := &gitlab.CreateBranchOptions{Branch: ptr.To("release/1.0"), Ref: ptr.To("main")}
+	if diff := cmp.Diff(want, GenerateCreateBranchOptions(p)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -17,8 +17,6 @@ limitations under the License.
 package projects
 
 import (
-	"strings"
-
 	"github.com/xanzy/go-gitlab"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
@@ -32,12 +30,12 @@ type DeployTokenClient interface {
 	GetProjectDeployToken(pid interface{}, deployToken int, options ...gitlab.RequestOptionFunc) (*gitlab.DeployToken, *gitlab.Response, error)
 }
 
-// IsErrorProjectDeployTokenNotFound helper function to test for errProjectDeployTokenNotFound error.
+// IsErrorProjectDeployTokenNotFound returns true if the supplied error reports a missing project deploy token.
+//
+// Deprecated: Use clients.IsNotFound, which does not depend on the error
+// message.
 func IsErrorProjectDeployTokenNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	return strings.Contains(err.Error(), errProjectNotFound)
+	return clients.IsNotFound(err)
 }
 
 // NewDeployTokenClient returns a new Gitlab ProjectDeployToken service
@@ -23,15 +23,27 @@ import (
 )
 
 var _ projects.Client = &MockClient{}
+var _ projects.ProtectedBranchClient = &MockClient{}
+var _ projects.ProtectedTagClient = &MockClient{}
+var _ projects.PushRulesClient = &MockClient{}
+var _ projects.ApprovalRuleClient = &MockClient{}
+var _ projects.ApprovalSettingsClient = &MockClient{}
+var _ projects.BranchClient = &MockClient{}
 
 // MockClient is a fake implementation of projects.Client.
 type MockClient struct {
 	projects.Client
 
-	MockGetProject    func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
-	MockCreateProject func(opt *gitlab.CreateProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
-	MockEditProject   func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
-	MockDeleteProject func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockGetProject                   func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	MockCreateProject                func(opt *gitlab.CreateProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	MockEditProject                  func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	MockDeleteProject                func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockArchiveProject               func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	MockUnarchiveProject             func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	MockShareProjectWithGroup        func(pid interface{}, opt *gitlab.ShareWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockDeleteSharedProjectFromGroup func(pid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockTransferProject              func(pid interface{}, opt *gitlab.TransferProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	MockGetProjectSharedWithGroups   func(pid interface{}, options ...gitlab.RequestOptionFunc) ([]projects.SharedWithGroup, *gitlab.Response, error)
 
 	MockGetHook    func(pid interface{}, hook int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error)
 	MockAddHook    func(pid interface{}, opt *gitlab.AddProjectHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error)
@@ -70,6 +82,32 @@ type MockClient struct {
 	MockEditPipelineScheduleVariable   func(pid interface{}, schedule int, key string, opt *gitlab.EditPipelineScheduleVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error)
 	MockDeletePipelineScheduleVariable func(pid interface{}, schedule int, key string, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error)
 
+	MockGetProtectedBranch          func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*projects.ProtectedBranch, *gitlab.Response, error)
+	MockProtectRepositoryBranches   func(pid interface{}, opt *gitlab.ProtectRepositoryBranchesOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedBranch, *gitlab.Response, error)
+	MockUpdateProtectedBranch       func(pid interface{}, branch string, opt *gitlab.UpdateProtectedBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedBranch, *gitlab.Response, error)
+	MockUnprotectRepositoryBranches func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetProtectedTag         func(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*projects.ProtectedTag, *gitlab.Response, error)
+	MockProtectRepositoryTags   func(pid interface{}, opt *projects.ProtectRepositoryTagsOptions, options ...gitlab.RequestOptionFunc) (*projects.ProtectedTag, *gitlab.Response, error)
+	MockUnprotectRepositoryTags func(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetProjectPushRules   func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error)
+	MockAddProjectPushRule    func(pid interface{}, opt *gitlab.AddProjectPushRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error)
+	MockEditProjectPushRule   func(pid interface{}, opt *gitlab.EditProjectPushRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error)
+	MockDeleteProjectPushRule func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetProjectApprovalRule    func(pid interface{}, ruleID int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error)
+	MockCreateProjectApprovalRule func(pid interface{}, opt *gitlab.CreateProjectLevelRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error)
+	MockUpdateProjectApprovalRule func(pid interface{}, approvalRule int, opt *gitlab.UpdateProjectLevelRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error)
+	MockDeleteProjectApprovalRule func(pid interface{}, approvalRule int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetApprovalConfiguration    func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error)
+	MockChangeApprovalConfiguration func(pid interface{}, opt *gitlab.ChangeApprovalConfigurationOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error)
+
+	MockGetBranch    func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error)
+	MockCreateBranch func(pid interface{}, opt *gitlab.CreateBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error)
+	MockDeleteBranch func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
 	MockListUsers func(opt *gitlab.ListUsersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.User, *gitlab.Response, error)
 }
 
@@ -125,7 +163,37 @@ func (c *MockClient) EditProject(pid interface{}, opt *gitlab.EditProjectOptions
 
 // DeleteProject calls the underlying MockDeleteProject method
 func (c *MockClient) DeleteProject(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
-	return c.MockDeleteProject(pid)
+	return c.MockDeleteProject(pid, options...)
+}
+
+// ArchiveProject calls the underlying MockArchiveProject method
+func (c *MockClient) ArchiveProject(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	return c.MockArchiveProject(pid, options...)
+}
+
+// UnarchiveProject calls the underlying MockUnarchiveProject method
+func (c *MockClient) UnarchiveProject(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	return c.MockUnarchiveProject(pid, options...)
+}
+
+// ShareProjectWithGroup calls the underlying MockShareProjectWithGroup method
+func (c *MockClient) ShareProjectWithGroup(pid interface{}, opt *gitlab.ShareWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockShareProjectWithGroup(pid, opt, options...)
+}
+
+// DeleteSharedProjectFromGroup calls the underlying MockDeleteSharedProjectFromGroup method
+func (c *MockClient) DeleteSharedProjectFromGroup(pid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteSharedProjectFromGroup(pid, groupID, options...)
+}
+
+// GetProjectSharedWithGroups calls the underlying MockGetProjectSharedWithGroups method
+func (c *MockClient) GetProjectSharedWithGroups(pid interface{}, options ...gitlab.RequestOptionFunc) ([]projects.SharedWithGroup, *gitlab.Response, error) {
+	return c.MockGetProjectSharedWithGroups(pid, options...)
+}
+
+// TransferProject calls the underlying MockTransferProject method
+func (c *MockClient) TransferProject(pid interface{}, opt *gitlab.TransferProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	return c.MockTransferProject(pid, opt)
 }
 
 // GetProjectHook calls the underlying MockGetProjectHook method.
@@ -252,3 +320,103 @@ func (c *MockClient) RevokeProjectAccessToken(pid interface{}, id int, options .
 func (c *MockClient) ListUsers(opt *gitlab.ListUsersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.User, *gitlab.Response, error) {
 	return c.MockListUsers(opt)
 }
+
+// GetProtectedBranch calls the underlying MockGetProtectedBranch method.
+func (c *MockClient) GetProtectedBranch(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*projects.ProtectedBranch, *gitlab.Response, error) {
+	return c.MockGetProtectedBranch(pid, branch)
+}
+
+// ProtectRepositoryBranches calls the underlying MockProtectRepositoryBranches method.
+func (c *MockClient) ProtectRepositoryBranches(pid interface{}, opt *gitlab.ProtectRepositoryBranchesOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedBranch, *gitlab.Response, error) {
+	return c.MockProtectRepositoryBranches(pid, opt)
+}
+
+// UpdateProtectedBranch calls the underlying MockUpdateProtectedBranch method.
+func (c *MockClient) UpdateProtectedBranch(pid interface{}, branch string, opt *gitlab.UpdateProtectedBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedBranch, *gitlab.Response, error) {
+	return c.MockUpdateProtectedBranch(pid, branch, opt)
+}
+
+// UnprotectRepositoryBranches calls the underlying MockUnprotectRepositoryBranches method.
+func (c *MockClient) UnprotectRepositoryBranches(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockUnprotectRepositoryBranches(pid, branch)
+}
+
+// GetProtectedTag calls the underlying MockGetProtectedTag method.
+func (c *MockClient) GetProtectedTag(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*projects.ProtectedTag, *gitlab.Response, error) {
+	return c.MockGetProtectedTag(pid, tag)
+}
+
+// ProtectRepositoryTags calls the underlying MockProtectRepositoryTags method.
+func (c *MockClient) ProtectRepositoryTags(pid interface{}, opt *projects.ProtectRepositoryTagsOptions, options ...gitlab.RequestOptionFunc) (*projects.ProtectedTag, *gitlab.Response, error) {
+	return c.MockProtectRepositoryTags(pid, opt)
+}
+
+// UnprotectRepositoryTags calls the underlying MockUnprotectRepositoryTags method.
+func (c *MockClient) UnprotectRepositoryTags(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockUnprotectRepositoryTags(pid, tag)
+}
+
+// GetProjectPushRules calls the underlying MockGetProjectPushRules method.
+func (c *MockClient) GetProjectPushRules(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+	return c.MockGetProjectPushRules(pid)
+}
+
+// AddProjectPushRule calls the underlying MockAddProjectPushRule method.
+func (c *MockClient) AddProjectPushRule(pid interface{}, opt *gitlab.AddProjectPushRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+	return c.MockAddProjectPushRule(pid, opt)
+}
+
+// EditProjectPushRule calls the underlying MockEditProjectPushRule method.
+func (c *MockClient) EditProjectPushRule(pid interface{}, opt *gitlab.EditProjectPushRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+	return c.MockEditProjectPushRule(pid, opt)
+}
+
+// DeleteProjectPushRule calls the underlying MockDeleteProjectPushRule method.
+func (c *MockClient) DeleteProjectPushRule(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteProjectPushRule(pid)
+}
+
+// GetProjectApprovalRule calls the underlying MockGetProjectApprovalRule method.
+func (c *MockClient) GetProjectApprovalRule(pid interface{}, ruleID int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+	return c.MockGetProjectApprovalRule(pid, ruleID)
+}
+
+// CreateProjectApprovalRule calls the underlying MockCreateProjectApprovalRule method.
+func (c *MockClient) CreateProjectApprovalRule(pid interface{}, opt *gitlab.CreateProjectLevelRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+	return c.MockCreateProjectApprovalRule(pid, opt)
+}
+
+// UpdateProjectApprovalRule calls the underlying MockUpdateProjectApprovalRule method.
+func (c *MockClient) UpdateProjectApprovalRule(pid interface{}, approvalRule int, opt *gitlab.UpdateProjectLevelRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+	return c.MockUpdateProjectApprovalRule(pid, approvalRule, opt)
+}
+
+// DeleteProjectApprovalRule calls the underlying MockDeleteProjectApprovalRule method.
+func (c *MockClient) DeleteProjectApprovalRule(pid interface{}, approvalRule int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteProjectApprovalRule(pid, approvalRule)
+}
+
+// GetApprovalConfiguration calls the underlying MockGetApprovalConfiguration method.
+func (c *MockClient) GetApprovalConfiguration(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error) {
+	return c.MockGetApprovalConfiguration(pid)
+}
+
+// ChangeApprovalConfiguration calls the underlying MockChangeApprovalConfiguration method.
+func (c *MockClient) ChangeApprovalConfiguration(pid interface{}, opt *gitlab.ChangeApprovalConfigurationOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error) {
+	return c.MockChangeApprovalConfiguration(pid, opt)
+}
+
+// GetBranch calls the underlying MockGetBranch method.
+func (c *MockClient) GetBranch(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+	return c.MockGetBranch(pid, branch)
+}
+
+// CreateBranch calls the underlying MockCreateBranch method.
+func (c *MockClient) CreateBranch(pid interface{}, opt *gitlab.CreateBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+	return c.MockCreateBranch(pid, opt)
+}
+
+// DeleteBranch calls the underlying MockDeleteBranch method.
+func (c *MockClient) DeleteBranch(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteBranch(pid, branch)
+}
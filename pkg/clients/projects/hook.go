@@ -17,8 +17,6 @@ limitations under the License.
 package projects
 
 import (
-	"strings"
-
 	"github.com/google/go-cmp/cmp"
 	"github.com/xanzy/go-gitlab"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -27,10 +25,6 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 )
 
-const (
-	errHookNotFound = "404 Not found"
-)
-
 // HookClient defines Gitlab Hook service operations
 type HookClient interface {
 	GetProjectHook(pid interface{}, hook int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error)
@@ -45,12 +39,12 @@ func NewHookClient(cfg clients.Config) HookClient {
 	return git.Projects
 }
 
-// IsErrorHookNotFound helper function to test for errProjectNotFound error.
+// IsErrorHookNotFound returns true if the supplied error reports a missing project hook.
+//
+// Deprecated: Use clients.IsNotFound, which does not depend on the error
+// message.
 func IsErrorHookNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	return strings.Contains(err.Error(), errHookNotFound)
+	return clients.IsNotFound(err)
 }
 
 // LateInitializeHook fills the empty fields in the hook spec with the
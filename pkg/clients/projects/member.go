@@ -17,8 +17,6 @@ limitations under the License.
 package projects
 
 import (
-	"strings"
-
 	"github.com/xanzy/go-gitlab"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -26,10 +24,6 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 )
 
-const (
-	errMemberNotFound = "404 Project Member Not Found"
-)
-
 // MemberClient defines Gitlab Member service operations
 type MemberClient interface {
 	GetProjectMember(pid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectMember, *gitlab.Response, error)
@@ -44,12 +38,12 @@ func NewMemberClient(cfg clients.Config) MemberClient {
 	return git.ProjectMembers
 }
 
-// IsErrorMemberNotFound helper function to test for errMemberNotFound error.
+// IsErrorMemberNotFound returns true if the supplied error reports a missing project member.
+//
+// Deprecated: Use clients.IsNotFound, which does not depend on the error
+// message.
 func IsErrorMemberNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	return strings.Contains(err.Error(), errMemberNotFound)
+	return clients.IsNotFound(err)
 }
 
 // GenerateMemberObservation is used to produce v1alpha1.MemberObservation from
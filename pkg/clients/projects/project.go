@@ -17,9 +17,10 @@ limitations under the License.
 package projects
 
 import (
-	"strings"
+	"net/http"
 	"time"
 
+	"github.com/hashicorp/go-retryablehttp"
 	"github.com/xanzy/go-gitlab"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -27,30 +28,90 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 )
 
-const (
-	errProjectNotFound = "404 Project Not Found"
-)
-
 // Client defines Gitlab Project service operations
 type Client interface {
 	GetProject(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
 	CreateProject(opt *gitlab.CreateProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
 	EditProject(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
 	DeleteProject(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	TransferProject(pid interface{}, opt *gitlab.TransferProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	ArchiveProject(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	UnarchiveProject(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	ShareProjectWithGroup(pid interface{}, opt *gitlab.ShareWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	DeleteSharedProjectFromGroup(pid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	GetProjectSharedWithGroups(pid interface{}, options ...gitlab.RequestOptionFunc) ([]SharedWithGroup, *gitlab.Response, error)
 }
 
 // NewProjectClient returns a new Gitlab Project service
 func NewProjectClient(cfg clients.Config) Client {
 	git := clients.NewClient(cfg)
-	return git.Projects
+	return &projectClient{
+		ProjectsService: git.Projects,
+		git:             git,
+	}
 }
 
-// IsErrorProjectNotFound helper function to test for errProjectNotFound error.
-func IsErrorProjectNotFound(err error) bool {
-	if err == nil {
-		return false
+type projectClient struct {
+	*gitlab.ProjectsService
+	git *gitlab.Client
+}
+
+// SharedWithGroup represents a group a project is shared with. It mirrors
+// the shared groups of gitlab.Project, which go-gitlab decodes without their
+// expiration date.
+type SharedWithGroup struct {
+	GroupID          int             `json:"group_id"`
+	GroupName        string          `json:"group_name"`
+	GroupFullPath    string          `json:"group_full_path"`
+	GroupAccessLevel int             `json:"group_access_level"`
+	ExpiresAt        *gitlab.ISOTime `json:"expires_at"`
+}
+
+// GetProjectSharedWithGroups gets the groups a project is shared with.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#get-single-project
+func (c *projectClient) GetProjectSharedWithGroups(pid interface{}, options ...gitlab.RequestOptionFunc) ([]SharedWithGroup, *gitlab.Response, error) {
+	project, err := projectPath(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.git.NewRequest(http.MethodGet, "projects/"+project, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prj := new(struct {
+		SharedWithGroups []SharedWithGroup `json:"shared_with_groups"`
+	})
+	resp, err := c.git.Do(req, prj)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return prj.SharedWithGroups, resp, nil
+}
+
+// WithPermanentRemoval makes DeleteProject remove a project that is marked
+// for deletion immediately. Gitlab requires the full path of the project to
+// confirm the removal.
+func WithPermanentRemoval(fullPath string) gitlab.RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		q := req.URL.Query()
+		q.Set("permanently_remove", "true")
+		q.Set("full_path", fullPath)
+		req.URL.RawQuery = q.Encode()
+		return nil
 	}
-	return strings.Contains(err.Error(), errProjectNotFound)
+}
+
+// IsErrorProjectNotFound returns true if the supplied error reports a missing project.
+//
+// Deprecated: Use clients.IsNotFound, which does not depend on the error
+// message.
+func IsErrorProjectNotFound(err error) bool {
+	return clients.IsNotFound(err)
 }
 
 // GenerateObservation is used to produce v1alpha1.ProjectObservation from
@@ -394,3 +455,19 @@ func GenerateEditProjectOptions(name string, p *v1alpha1.ProjectParameters) *git
 	}
 	return o
 }
+
+// SupportedParameters returns the supplied parameters without the fields the
+// supplied Gitlab instance cannot honor, and the names of the removed fields
+// that were set. The supplied parameters are not modified.
+func SupportedParameters(p *v1alpha1.ProjectParameters, i clients.Instance) (*v1alpha1.ProjectParameters, []string) {
+	if i.SupportsPremiumFeatures() {
+		return p, nil
+	}
+	sp := p.DeepCopy()
+	var unsupported []string
+	if sp.ApprovalsBeforeMerge != nil {
+		unsupported = append(unsupported, "approvalsBeforeMerge")
+		sp.ApprovalsBeforeMerge = nil
+	}
+	return sp, unsupported
+}
@@ -17,10 +17,12 @@ limitations under the License.
 package projects
 
 import (
+	"net/http"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-retryablehttp"
 	"github.com/xanzy/go-gitlab"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -770,3 +772,69 @@ func TestGenerateEditProjectOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestSupportedParameters(t *testing.T) {
+	approvals := 2
+	p := &v1alpha1.ProjectParameters{Path: &path, ApprovalsBeforeMerge: &approvals}
+
+	type want struct {
+		params      *v1alpha1.ProjectParameters
+		unsupported []string
+	}
+
+	cases := map[string]struct {
+		instance clients.Instance
+		want     want
+	}{
+		"EnterpriseEdition": {
+			instance: clients.Instance{Edition: clients.EditionEE, Tier: clients.TierPremium},
+			want:     want{params: p},
+		},
+		"EnterpriseEditionFree": {
+			instance: clients.Instance{Edition: clients.EditionEE, Tier: clients.TierFree},
+			want: want{
+				params:      &v1alpha1.ProjectParameters{Path: &path},
+				unsupported: []string{"approvalsBeforeMerge"},
+			},
+		},
+		"UnknownEdition": {
+			instance: clients.Instance{},
+			want:     want{params: p},
+		},
+		"CommunityEdition": {
+			instance: clients.Instance{Edition: clients.EditionCE},
+			want: want{
+				params:      &v1alpha1.ProjectParameters{Path: &path},
+				unsupported: []string{"approvalsBeforeMerge"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			params, unsupported := SupportedParameters(p, tc.instance)
+			if diff := cmp.Diff(tc.want.params, params); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.unsupported, unsupported); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if p.ApprovalsBeforeMerge == nil {
+				t.Errorf("SupportedParameters(...): must not modify the supplied parameters")
+			}
+		})
+	}
+}
+
+func TestWithPermanentRemoval(t *testing.T) {
+	req, err := retryablehttp.NewRequest(http.MethodDelete, "https://gitlab.com/api/v4/projects/1234", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := WithPermanentRemoval("group/my project")(req); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff("full_path=group%2Fmy+project&permanently_remove=true", req.URL.RawQuery); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,288 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+const (
+	errInvalidProjectID = "invalid ID type %#v, the ID must be an int or a string"
+)
+
+// ProtectedBranchClient defines Gitlab protected branch service operations
+type ProtectedBranchClient interface {
+	GetProtectedBranch(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*ProtectedBranch, *gitlab.Response, error)
+	ProtectRepositoryBranches(pid interface{}, opt *gitlab.ProtectRepositoryBranchesOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedBranch, *gitlab.Response, error)
+	UpdateProtectedBranch(pid interface{}, branch string, opt *gitlab.UpdateProtectedBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedBranch, *gitlab.Response, error)
+	UnprotectRepositoryBranches(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// ProtectedBranch represents a protected branch. It mirrors
+// gitlab.ProtectedBranch but keeps the deploy keys of the access levels.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/protected_branches.html
+type ProtectedBranch struct {
+	ID                        int                        `json:"id"`
+	Name                      string                     `json:"name"`
+	PushAccessLevels          []*BranchAccessDescription `json:"push_access_levels"`
+	MergeAccessLevels         []*BranchAccessDescription `json:"merge_access_levels"`
+	UnprotectAccessLevels     []*BranchAccessDescription `json:"unprotect_access_levels"`
+	AllowForcePush            bool                       `json:"allow_force_push"`
+	CodeOwnerApprovalRequired bool                       `json:"code_owner_approval_required"`
+}
+
+// BranchAccessDescription represents the access description for a protected
+// branch. go-gitlab does not decode the deploy key of an access level yet.
+type BranchAccessDescription struct {
+	gitlab.BranchAccessDescription
+	DeployKeyID int `json:"deploy_key_id"`
+}
+
+// NewProtectedBranchClient returns a new Gitlab protected branch service
+func NewProtectedBranchClient(cfg clients.Config) ProtectedBranchClient {
+	git := clients.NewClient(cfg)
+	return &protectedBranchClient{
+		ProtectedBranchesService: git.ProtectedBranches,
+		git:                      git,
+	}
+}
+
+type protectedBranchClient struct {
+	*gitlab.ProtectedBranchesService
+	git *gitlab.Client
+}
+
+// GetProtectedBranch gets a single protected branch or wildcard protected
+// branch.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/protected_branches.html#get-a-single-protected-branch-or-wildcard-protected-branch
+func (c *protectedBranchClient) GetProtectedBranch(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*ProtectedBranch, *gitlab.Response, error) {
+	project, err := projectPath(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/protected_branches/%s", project, gitlab.PathEscape(branch))
+
+	req, err := c.git.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pb := new(ProtectedBranch)
+	resp, err := c.git.Do(req, pb)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pb, resp, nil
+}
+
+func projectPath(pid interface{}) (string, error) {
+	switch v := pid.(type) {
+	case int:
+		return strconv.Itoa(v), nil
+	case string:
+		return gitlab.PathEscape(v), nil
+	default:
+		return "", errors.Errorf(errInvalidProjectID, pid)
+	}
+}
+
+// LateInitializeProtectedBranch fills the empty fields in the protected
+// branch spec with the values seen in Gitlab.
+func LateInitializeProtectedBranch(in *v1alpha1.ProtectedBranchParameters, pb *ProtectedBranch) {
+	if pb == nil {
+		return
+	}
+
+	in.PushAccessLevel = lateInitializeRole(in.PushAccessLevel, pb.PushAccessLevels)
+	in.MergeAccessLevel = lateInitializeRole(in.MergeAccessLevel, pb.MergeAccessLevels)
+	in.UnprotectAccessLevel = lateInitializeRole(in.UnprotectAccessLevel, pb.UnprotectAccessLevels)
+
+	if in.AllowForcePush == nil {
+		in.AllowForcePush = &pb.AllowForcePush
+	}
+
+	if in.CodeOwnerApprovalRequired == nil {
+		in.CodeOwnerApprovalRequired = &pb.CodeOwnerApprovalRequired
+	}
+}
+
+func lateInitializeRole(in *v1alpha1.AccessLevelValue, from []*BranchAccessDescription) *v1alpha1.AccessLevelValue {
+	if in != nil {
+		return in
+	}
+	for _, d := range from {
+		if a := accessOf(d); a.isRole() {
+			return ptr.To(v1alpha1.AccessLevelValue(a.accessLevel))
+		}
+	}
+	return nil
+}
+
+// GenerateProtectRepositoryBranchesOptions generates protected branch
+// creation options
+func GenerateProtectRepositoryBranchesOptions(p *v1alpha1.ProtectedBranchParameters) *gitlab.ProtectRepositoryBranchesOptions {
+	return &gitlab.ProtectRepositoryBranchesOptions{
+		Name:                      &p.Name,
+		PushAccessLevel:           (*gitlab.AccessLevelValue)(p.PushAccessLevel),
+		MergeAccessLevel:          (*gitlab.AccessLevelValue)(p.MergeAccessLevel),
+		UnprotectAccessLevel:      (*gitlab.AccessLevelValue)(p.UnprotectAccessLevel),
+		AllowForcePush:            p.AllowForcePush,
+		AllowedToPush:             generateBranchPermissions(p.AllowedToPush),
+		AllowedToMerge:            generateBranchPermissions(p.AllowedToMerge),
+		AllowedToUnprotect:        generateBranchPermissions(p.AllowedToUnprotect),
+		CodeOwnerApprovalRequired: p.CodeOwnerApprovalRequired,
+	}
+}
+
+func generateBranchPermissions(allowed []v1alpha1.BranchPermission) *[]*gitlab.BranchPermissionOptions {
+	if len(allowed) == 0 {
+		return nil
+	}
+	opts := make([]*gitlab.BranchPermissionOptions, 0, len(allowed))
+	for _, a := range allowed {
+		opts = append(opts, permissionOf(a).options())
+	}
+	return &opts
+}
+
+// GenerateUpdateProtectedBranchOptions generates protected branch update
+// options. Access levels are converged by removing the ones Gitlab has but
+// the spec does not and adding the ones the spec has but Gitlab does not.
+func GenerateUpdateProtectedBranchOptions(p *v1alpha1.ProtectedBranchParameters, pb *ProtectedBranch) *gitlab.UpdateProtectedBranchOptions {
+	return &gitlab.UpdateProtectedBranchOptions{
+		AllowForcePush:            p.AllowForcePush,
+		CodeOwnerApprovalRequired: p.CodeOwnerApprovalRequired,
+		AllowedToPush:             nilIfEmpty(diffBranchPermissions(p.PushAccessLevel, p.AllowedToPush, pb.PushAccessLevels)),
+		AllowedToMerge:            nilIfEmpty(diffBranchPermissions(p.MergeAccessLevel, p.AllowedToMerge, pb.MergeAccessLevels)),
+		AllowedToUnprotect:        nilIfEmpty(diffBranchPermissions(p.UnprotectAccessLevel, p.AllowedToUnprotect, pb.UnprotectAccessLevels)),
+	}
+}
+
+func nilIfEmpty(opts []*gitlab.BranchPermissionOptions) *[]*gitlab.BranchPermissionOptions {
+	if len(opts) == 0 {
+		return nil
+	}
+	return &opts
+}
+
+// IsProtectedBranchUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsProtectedBranchUpToDate(p *v1alpha1.ProtectedBranchParameters, pb *ProtectedBranch) bool {
+	if p == nil {
+		return true
+	}
+
+	return clients.IsBoolEqualToBoolPtr(p.AllowForcePush, pb.AllowForcePush) &&
+		clients.IsBoolEqualToBoolPtr(p.CodeOwnerApprovalRequired, pb.CodeOwnerApprovalRequired) &&
+		len(diffBranchPermissions(p.PushAccessLevel, p.AllowedToPush, pb.PushAccessLevels)) == 0 &&
+		len(diffBranchPermissions(p.MergeAccessLevel, p.AllowedToMerge, pb.MergeAccessLevels)) == 0 &&
+		len(diffBranchPermissions(p.UnprotectAccessLevel, p.AllowedToUnprotect, pb.UnprotectAccessLevels)) == 0
+}
+
+// protectedAccess identifies an access level of a protected branch or tag.
+// Role based access levels only have an accessLevel, allowances only have one
+// of the IDs.
+type protectedAccess struct {
+	accessLevel gitlab.AccessLevelValue
+	userID      int
+	groupID     int
+	deployKeyID int
+}
+
+func accessOf(d *BranchAccessDescription) protectedAccess {
+	if d.UserID != 0 || d.GroupID != 0 || d.DeployKeyID != 0 {
+		return protectedAccess{userID: d.UserID, groupID: d.GroupID, deployKeyID: d.DeployKeyID}
+	}
+	return protectedAccess{accessLevel: d.AccessLevel}
+}
+
+func permissionOf(p v1alpha1.BranchPermission) protectedAccess {
+	return protectedAccess{
+		userID:      ptr.Deref(p.UserID, 0),
+		groupID:     ptr.Deref(p.GroupID, 0),
+		deployKeyID: ptr.Deref(p.DeployKeyID, 0),
+	}
+}
+
+func (a protectedAccess) isRole() bool {
+	return a.userID == 0 && a.groupID == 0 && a.deployKeyID == 0
+}
+
+func (a protectedAccess) options() *gitlab.BranchPermissionOptions {
+	switch {
+	case a.userID != 0:
+		return &gitlab.BranchPermissionOptions{UserID: gitlab.Int(a.userID)}
+	case a.groupID != 0:
+		return &gitlab.BranchPermissionOptions{GroupID: gitlab.Int(a.groupID)}
+	case a.deployKeyID != 0:
+		return &gitlab.BranchPermissionOptions{DeployKeyID: gitlab.Int(a.deployKeyID)}
+	default:
+		return &gitlab.BranchPermissionOptions{AccessLevel: gitlab.AccessLevel(a.accessLevel)}
+	}
+}
+
+// diffBranchPermissions returns the options that make the observed access
+// levels match the desired role and allowances. Observed roles are left
+// alone if no role is desired.
+func diffBranchPermissions(role *v1alpha1.AccessLevelValue, allowed []v1alpha1.BranchPermission, observed []*BranchAccessDescription) []*gitlab.BranchPermissionOptions {
+	desired := make([]protectedAccess, 0, len(allowed)+1)
+	if role != nil {
+		desired = append(desired, protectedAccess{accessLevel: gitlab.AccessLevelValue(*role)})
+	}
+	for _, a := range allowed {
+		desired = append(desired, permissionOf(a))
+	}
+
+	want := make(map[protectedAccess]bool, len(desired))
+	for _, a := range desired {
+		want[a] = true
+	}
+
+	var opts []*gitlab.BranchPermissionOptions
+	for _, d := range observed {
+		a := accessOf(d)
+		if want[a] {
+			delete(want, a)
+			continue
+		}
+		if role == nil && a.isRole() {
+			continue
+		}
+		opts = append(opts, &gitlab.BranchPermissionOptions{ID: gitlab.Int(d.ID), Destroy: gitlab.Bool(true)})
+	}
+	for _, a := range desired {
+		if want[a] {
+			delete(want, a)
+			opts = append(opts, a.options())
+		}
+	}
+	return opts
+}
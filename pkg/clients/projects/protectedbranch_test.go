@@ -0,0 +1,261 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+func roleAccess(id int, level gitlab.AccessLevelValue) *BranchAccessDescription {
+	return &BranchAccessDescription{BranchAccessDescription: gitlab.BranchAccessDescription{ID: id, AccessLevel: level}}
+}
+
+func userAccess(id, user int) *BranchAccessDescription {
+	return &BranchAccessDescription{BranchAccessDescription: gitlab.BranchAccessDescription{ID: id, AccessLevel: gitlab.MaintainerPermissions, UserID: user}}
+}
+
+func deployKeyAccess(id, key int) *BranchAccessDescription {
+	return &BranchAccessDescription{BranchAccessDescription: gitlab.BranchAccessDescription{ID: id, AccessLevel: gitlab.MaintainerPermissions}, DeployKeyID: key}
+}
+
+func TestGetProtectedBranch(t *testing.T) {
+	var path string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.EscapedPath()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":1,"name":"release/*","push_access_levels":[{"id":2,"access_level":40,"deploy_key_id":3}],"allow_force_push":true}`))
+	}))
+	defer srv.Close()
+
+	c := NewProtectedBranchClient(clients.Config{Token: "token", BaseURL: srv.URL})
+	pb, _, err := c.GetProtectedBranch("group/project", "release/*")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ProtectedBranch{
+		ID:               1,
+		Name:             "release/*",
+		PushAccessLevels: []*BranchAccessDescription{deployKeyAccess(2, 3)},
+		AllowForcePush:   true,
+	}
+	if diff := cmp.Diff(want, pb); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("/api/v4/projects/group%2Fproject/protected_branches/release%2F%2A", path); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeProtectedBranch(t *testing.T) {
+	cases := map[string]struct {
+		parameters *v1alpha1.ProtectedBranchParameters
+		pb         *ProtectedBranch
+		want       *v1alpha1.ProtectedBranchParameters
+	}{
+		"AllOptionalFields": {
+			parameters: &v1alpha1.ProtectedBranchParameters{},
+			pb: &ProtectedBranch{
+				PushAccessLevels:      []*BranchAccessDescription{userAccess(1, 5), roleAccess(2, gitlab.NoPermissions)},
+				MergeAccessLevels:     []*BranchAccessDescription{roleAccess(3, gitlab.DeveloperPermissions)},
+				UnprotectAccessLevels: []*BranchAccessDescription{userAccess(4, 5)},
+				AllowForcePush:        true,
+			},
+			want: &v1alpha1.ProtectedBranchParameters{
+				PushAccessLevel:           ptr.To(v1alpha1.AccessLevelValue(0)),
+				MergeAccessLevel:          ptr.To(v1alpha1.AccessLevelValue(30)),
+				AllowForcePush:            ptr.To(true),
+				CodeOwnerApprovalRequired: ptr.To(false),
+			},
+		},
+		"SomeFieldsDontOverwrite": {
+			parameters: &v1alpha1.ProtectedBranchParameters{
+				PushAccessLevel: ptr.To(v1alpha1.AccessLevelValue(40)),
+				AllowForcePush:  ptr.To(false),
+			},
+			pb: &ProtectedBranch{
+				PushAccessLevels: []*BranchAccessDescription{roleAccess(1, gitlab.DeveloperPermissions)},
+				AllowForcePush:   true,
+			},
+			want: &v1alpha1.ProtectedBranchParameters{
+				PushAccessLevel:           ptr.To(v1alpha1.AccessLevelValue(40)),
+				AllowForcePush:            ptr.To(false),
+				CodeOwnerApprovalRequired: ptr.To(false),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeProtectedBranch(tc.parameters, tc.pb)
+			if diff := cmp.Diff(tc.want, tc.parameters); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateProtectRepositoryBranchesOptions(t *testing.T) {
+	p := &v1alpha1.ProtectedBranchParameters{
+		Name:             "main",
+		PushAccessLevel:  ptr.To(v1alpha1.AccessLevelValue(0)),
+		MergeAccessLevel: ptr.To(v1alpha1.AccessLevelValue(40)),
+		AllowedToPush:    []v1alpha1.BranchPermission{{DeployKeyID: ptr.To(3)}},
+		AllowedToMerge:   []v1alpha1.BranchPermission{{UserID: ptr.To(5)}, {GroupID: ptr.To(6)}},
+		AllowForcePush:   ptr.To(true),
+	}
+	want := &gitlab.ProtectRepositoryBranchesOptions{
+		Name:             gitlab.String("main"),
+		PushAccessLevel:  gitlab.AccessLevel(gitlab.NoPermissions),
+		MergeAccessLevel: gitlab.AccessLevel(gitlab.MaintainerPermissions),
+		AllowedToPush:    &[]*gitlab.BranchPermissionOptions{{DeployKeyID: gitlab.Int(3)}},
+		AllowedToMerge:   &[]*gitlab.BranchPermissionOptions{{UserID: gitlab.Int(5)}, {GroupID: gitlab.Int(6)}},
+		AllowForcePush:   gitlab.Bool(true),
+	}
+
+	if diff := cmp.Diff(want, GenerateProtectRepositoryBranchesOptions(p)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateUpdateProtectedBranchOptions(t *testing.T) {
+	cases := map[string]struct {
+		parameters *v1alpha1.ProtectedBranchParameters
+		pb         *ProtectedBranch
+		want       *gitlab.UpdateProtectedBranchOptions
+	}{
+		"UpToDate": {
+			parameters: &v1alpha1.ProtectedBranchParameters{
+				PushAccessLevel: ptr.To(v1alpha1.AccessLevelValue(40)),
+				AllowedToPush:   []v1alpha1.BranchPermission{{UserID: ptr.To(5)}, {DeployKeyID: ptr.To(3)}},
+			},
+			pb: &ProtectedBranch{
+				PushAccessLevels: []*BranchAccessDescription{deployKeyAccess(1, 3), roleAccess(2, gitlab.MaintainerPermissions), userAccess(3, 5)},
+			},
+			want: &gitlab.UpdateProtectedBranchOptions{},
+		},
+		"ChangedRole": {
+			parameters: &v1alpha1.ProtectedBranchParameters{
+				MergeAccessLevel: ptr.To(v1alpha1.AccessLevelValue(30)),
+			},
+			pb: &ProtectedBranch{
+				MergeAccessLevels: []*BranchAccessDescription{roleAccess(1, gitlab.MaintainerPermissions)},
+			},
+			want: &gitlab.UpdateProtectedBranchOptions{
+				AllowedToMerge: &[]*gitlab.BranchPermissionOptions{
+					{ID: gitlab.Int(1), Destroy: gitlab.Bool(true)},
+					{AccessLevel: gitlab.AccessLevel(gitlab.DeveloperPermissions)},
+				},
+			},
+		},
+		"ChangedAllowances": {
+			parameters: &v1alpha1.ProtectedBranchParameters{
+				AllowedToUnprotect: []v1alpha1.BranchPermission{{GroupID: ptr.To(6)}},
+				AllowForcePush:     ptr.To(true),
+			},
+			pb: &ProtectedBranch{
+				UnprotectAccessLevels: []*BranchAccessDescription{roleAccess(1, gitlab.MaintainerPermissions), userAccess(2, 5)},
+			},
+			want: &gitlab.UpdateProtectedBranchOptions{
+				AllowForcePush: gitlab.Bool(true),
+				AllowedToUnprotect: &[]*gitlab.BranchPermissionOptions{
+					{ID: gitlab.Int(2), Destroy: gitlab.Bool(true)},
+					{GroupID: gitlab.Int(6)},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateProtectedBranchOptions(tc.parameters, tc.pb)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsProtectedBranchUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		parameters *v1alpha1.ProtectedBranchParameters
+		pb         *ProtectedBranch
+		want       bool
+	}{
+		"UpToDate": {
+			parameters: &v1alpha1.ProtectedBranchParameters{
+				PushAccessLevel: ptr.To(v1alpha1.AccessLevelValue(40)),
+				AllowForcePush:  ptr.To(true),
+			},
+			pb: &ProtectedBranch{
+				PushAccessLevels:      []*BranchAccessDescription{roleAccess(1, gitlab.MaintainerPermissions)},
+				MergeAccessLevels:     []*BranchAccessDescription{roleAccess(2, gitlab.MaintainerPermissions)},
+				UnprotectAccessLevels: []*BranchAccessDescription{roleAccess(3, gitlab.MaintainerPermissions)},
+				AllowForcePush:        true,
+			},
+			want: true,
+		},
+		"AllowForcePush": {
+			parameters: &v1alpha1.ProtectedBranchParameters{
+				AllowForcePush: ptr.To(false),
+			},
+			pb: &ProtectedBranch{
+				AllowForcePush: true,
+			},
+			want: false,
+		},
+		"CodeOwnerApprovalRequired": {
+			parameters: &v1alpha1.ProtectedBranchParameters{
+				CodeOwnerApprovalRequired: ptr.To(true),
+			},
+			pb:   &ProtectedBranch{},
+			want: false,
+		},
+		"MissingAllowance": {
+			parameters: &v1alpha1.ProtectedBranchParameters{
+				AllowedToPush: []v1alpha1.BranchPermission{{DeployKeyID: ptr.To(3)}},
+			},
+			pb:   &ProtectedBranch{},
+			want: false,
+		},
+		"ExtraAllowance": {
+			parameters: &v1alpha1.ProtectedBranchParameters{},
+			pb: &ProtectedBranch{
+				MergeAccessLevels: []*BranchAccessDescription{userAccess(1, 5)},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsProtectedBranchUpToDate(tc.parameters, tc.pb)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// ProtectedTagClient defines Gitlab protected tag service operations
+type ProtectedTagClient interface {
+	GetProtectedTag(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*ProtectedTag, *gitlab.Response, error)
+	ProtectRepositoryTags(pid interface{}, opt *ProtectRepositoryTagsOptions, options ...gitlab.RequestOptionFunc) (*ProtectedTag, *gitlab.Response, error)
+	UnprotectRepositoryTags(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// ProtectedTag represents a protected tag. It mirrors gitlab.ProtectedTag but
+// keeps the deploy keys of the access levels.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/protected_tags.html
+type ProtectedTag struct {
+	Name               string                  `json:"name"`
+	CreateAccessLevels []*TagAccessDescription `json:"create_access_levels"`
+}
+
+// TagAccessDescription represents the access description for a protected
+// tag. go-gitlab does not decode the deploy key of an access level yet.
+type TagAccessDescription struct {
+	gitlab.TagAccessDescription
+	DeployKeyID int `json:"deploy_key_id"`
+}
+
+// ProtectRepositoryTagsOptions represents the available
+// ProtectRepositoryTags() options. It mirrors
+// gitlab.ProtectRepositoryTagsOptions but allows deploy keys to create tags.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/protected_tags.html#protect-repository-tags
+type ProtectRepositoryTagsOptions struct {
+	Name              *string                  `url:"name,omitempty" json:"name,omitempty"`
+	CreateAccessLevel *gitlab.AccessLevelValue `url:"create_access_level,omitempty" json:"create_access_level,omitempty"`
+	AllowedToCreate   *[]*TagPermissionOptions `url:"allowed_to_create,omitempty" json:"allowed_to_create,omitempty"`
+}
+
+// TagPermissionOptions represents a protected tag permission option.
+type TagPermissionOptions struct {
+	UserID      *int                     `url:"user_id,omitempty" json:"user_id,omitempty"`
+	GroupID     *int                     `url:"group_id,omitempty" json:"group_id,omitempty"`
+	DeployKeyID *int                     `url:"deploy_key_id,omitempty" json:"deploy_key_id,omitempty"`
+	AccessLevel *gitlab.AccessLevelValue `url:"access_level,omitempty" json:"access_level,omitempty"`
+}
+
+// NewProtectedTagClient returns a new Gitlab protected tag service
+func NewProtectedTagClient(cfg clients.Config) ProtectedTagClient {
+	git := clients.NewClient(cfg)
+	return &protectedTagClient{
+		ProtectedTagsService: git.ProtectedTags,
+		git:                  git,
+	}
+}
+
+type protectedTagClient struct {
+	*gitlab.ProtectedTagsService
+	git *gitlab.Client
+}
+
+// GetProtectedTag returns a single protected tag or wildcard protected tag.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/protected_tags.html#get-a-single-protected-tag-or-wildcard-protected-tag
+func (c *protectedTagClient) GetProtectedTag(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*ProtectedTag, *gitlab.Response, error) {
+	project, err := projectPath(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/protected_tags/%s", project, gitlab.PathEscape(tag))
+
+	return c.do(http.MethodGet, u, nil, options)
+}
+
+// ProtectRepositoryTags protects a single repository tag or several project
+// repository tags using a wildcard protected tag.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/protected_tags.html#protect-repository-tags
+func (c *protectedTagClient) ProtectRepositoryTags(pid interface{}, opt *ProtectRepositoryTagsOptions, options ...gitlab.RequestOptionFunc) (*ProtectedTag, *gitlab.Response, error) {
+	project, err := projectPath(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/protected_tags", project)
+
+	return c.do(http.MethodPost, u, opt, options)
+}
+
+func (c *protectedTagClient) do(method, u string, opt interface{}, options []gitlab.RequestOptionFunc) (*ProtectedTag, *gitlab.Response, error) {
+	req, err := c.git.NewRequest(method, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pt := new(ProtectedTag)
+	resp, err := c.git.Do(req, pt)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pt, resp, nil
+}
+
+// LateInitializeProtectedTag fills the empty fields in the protected tag spec
+// with the values seen in Gitlab.
+func LateInitializeProtectedTag(in *v1alpha1.ProtectedTagParameters, pt *ProtectedTag) {
+	if pt == nil {
+		return
+	}
+
+	if len(in.CreateAccessLevels) == 0 && len(pt.CreateAccessLevels) > 0 {
+		in.CreateAccessLevels = make([]v1alpha1.TagAccessLevel, 0, len(pt.CreateAccessLevels))
+		for _, d := range pt.CreateAccessLevels {
+			in.CreateAccessLevels = append(in.CreateAccessLevels, tagAccessOf(d).tagAccessLevel())
+		}
+	}
+}
+
+// GenerateProtectRepositoryTagsOptions generates protected tag creation
+// options. The first role is sent as the create access level, which is all
+// Gitlab Free supports; everything else is sent as allowed to create. Without
+// a role no access is sent as create access level, as Gitlab would otherwise
+// allow Maintainers to create the tags.
+func GenerateProtectRepositoryTagsOptions(p *v1alpha1.ProtectedTagParameters) *ProtectRepositoryTagsOptions {
+	opt := &ProtectRepositoryTagsOptions{Name: &p.Name}
+
+	var allowed []*TagPermissionOptions
+	for _, l := range p.CreateAccessLevels {
+		a := tagPermissionOf(l)
+		if a.isRole() && opt.CreateAccessLevel == nil {
+			opt.CreateAccessLevel = gitlab.AccessLevel(a.accessLevel)
+			continue
+		}
+		allowed = append(allowed, a.tagOptions())
+	}
+	if len(allowed) > 0 {
+		opt.AllowedToCreate = &allowed
+		if opt.CreateAccessLevel == nil {
+			opt.CreateAccessLevel = gitlab.AccessLevel(gitlab.NoPermissions)
+		}
+	}
+
+	return opt
+}
+
+// IsProtectedTagUpToDate checks whether the access levels allowed to create
+// tags match. If no role is desired the roles seen in Gitlab are ignored,
+// like for protected branches.
+func IsProtectedTagUpToDate(p *v1alpha1.ProtectedTagParameters, pt *ProtectedTag) bool {
+	if p == nil {
+		return true
+	}
+
+	role := false
+	want := make(map[protectedAccess]bool, len(p.CreateAccessLevels))
+	for _, l := range p.CreateAccessLevels {
+		a := tagPermissionOf(l)
+		want[a] = true
+		role = role || a.isRole()
+	}
+	got := make(map[protectedAccess]bool, len(pt.CreateAccessLevels))
+	for _, d := range pt.CreateAccessLevels {
+		if a := tagAccessOf(d); role || !a.isRole() {
+			got[a] = true
+		}
+	}
+
+	if len(want) != len(got) {
+		return false
+	}
+	for a := range want {
+		if !got[a] {
+			return false
+		}
+	}
+	return true
+}
+
+func tagAccessOf(d *TagAccessDescription) protectedAccess {
+	if d.UserID != 0 || d.GroupID != 0 || d.DeployKeyID != 0 {
+		return protectedAccess{userID: d.UserID, groupID: d.GroupID, deployKeyID: d.DeployKeyID}
+	}
+	return protectedAccess{accessLevel: d.AccessLevel}
+}
+
+func tagPermissionOf(l v1alpha1.TagAccessLevel) protectedAccess {
+	a := protectedAccess{
+		userID:      ptr.Deref(l.UserID, 0),
+		groupID:     ptr.Deref(l.GroupID, 0),
+		deployKeyID: ptr.Deref(l.DeployKeyID, 0),
+	}
+	if a.isRole() {
+		a.accessLevel = gitlab.AccessLevelValue(ptr.Deref(l.AccessLevel, 0))
+	}
+	return a
+}
+
+func (a protectedAccess) tagAccessLevel() v1alpha1.TagAccessLevel {
+	switch {
+	case a.userID != 0:
+		return v1alpha1.TagAccessLevel{UserID: ptr.To(a.userID)}
+	case a.groupID != 0:
+		return v1alpha1.TagAccessLevel{GroupID: ptr.To(a.groupID)}
+	case a.deployKeyID != 0:
+		return v1alpha1.TagAccessLevel{DeployKeyID: ptr.To(a.deployKeyID)}
+	default:
+		return v1alpha1.TagAccessLevel{AccessLevel: ptr.To(v1alpha1.AccessLevelValue(a.accessLevel))}
+	}
+}
+
+func (a protectedAccess) tagOptions() *TagPermissionOptions {
+	switch {
+	case a.userID != 0:
+		return &TagPermissionOptions{UserID: gitlab.Int(a.userID)}
+	case a.groupID != 0:
+		return &TagPermissionOptions{GroupID: gitlab.Int(a.groupID)}
+	case a.deployKeyID != 0:
+		return &TagPermissionOptions{DeployKeyID: gitlab.Int(a.deployKeyID)}
+	default:
+		return &TagPermissionOptions{AccessLevel: gitlab.AccessLevel(a.accessLevel)}
+	}
+}
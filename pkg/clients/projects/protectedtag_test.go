@@ -0,0 +1,244 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+func tagRoleAccess(level gitlab.AccessLevelValue) *TagAccessDescription {
+	return &TagAccessDescription{TagAccessDescription: gitlab.TagAccessDescription{AccessLevel: level}}
+}
+
+func tagDeployKeyAccess(key int) *TagAccessDescription {
+	return &TagAccessDescription{TagAccessDescription: gitlab.TagAccessDescription{AccessLevel: gitlab.MaintainerPermissions}, DeployKeyID: key}
+}
+
+func TestProtectRepositoryTags(t *testing.T) {
+	var body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name":"v*","create_access_levels":[{"access_level":40},{"access_level":40,"deploy_key_id":3}]}`))
+	}))
+	defer srv.Close()
+
+	c := NewProtectedTagClient(clients.Config{Token: "token", BaseURL: srv.URL})
+	pt, _, err := c.ProtectRepositoryTags(1, &ProtectRepositoryTagsOptions{
+		Name:              gitlab.String("v*"),
+		CreateAccessLevel: gitlab.AccessLevel(gitlab.MaintainerPermissions),
+		AllowedToCreate:   &[]*TagPermissionOptions{{DeployKeyID: gitlab.Int(3)}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ProtectedTag{
+		Name:               "v*",
+		CreateAccessLevels: []*TagAccessDescription{tagRoleAccess(gitlab.MaintainerPermissions), tagDeployKeyAccess(3)},
+	}
+	if diff := cmp.Diff(want, pt); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(`{"name":"v*","create_access_level":40,"allowed_to_create":[{"deploy_key_id":3}]}`, body); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeProtectedTag(t *testing.T) {
+	cases := map[string]struct {
+		parameters *v1alpha1.ProtectedTagParameters
+		pt         *ProtectedTag
+		want       *v1alpha1.ProtectedTagParameters
+	}{
+		"AllOptionalFields": {
+			parameters: &v1alpha1.ProtectedTagParameters{},
+			pt: &ProtectedTag{
+				CreateAccessLevels: []*TagAccessDescription{tagRoleAccess(gitlab.MaintainerPermissions), tagDeployKeyAccess(3)},
+			},
+			want: &v1alpha1.ProtectedTagParameters{
+				CreateAccessLevels: []v1alpha1.TagAccessLevel{
+					{AccessLevel: ptr.To(v1alpha1.AccessLevelValue(40))},
+					{DeployKeyID: ptr.To(3)},
+				},
+			},
+		},
+		"SomeFieldsDontOverwrite": {
+			parameters: &v1alpha1.ProtectedTagParameters{
+				CreateAccessLevels: []v1alpha1.TagAccessLevel{{UserID: ptr.To(5)}},
+			},
+			pt: &ProtectedTag{
+				CreateAccessLevels: []*TagAccessDescription{tagRoleAccess(gitlab.MaintainerPermissions)},
+			},
+			want: &v1alpha1.ProtectedTagParameters{
+				CreateAccessLevels: []v1alpha1.TagAccessLevel{{UserID: ptr.To(5)}},
+			},
+		},
+		"NoAccessLevels": {
+			parameters: &v1alpha1.ProtectedTagParameters{},
+			pt:         &ProtectedTag{},
+			want:       &v1alpha1.ProtectedTagParameters{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeProtectedTag(tc.parameters, tc.pt)
+			if diff := cmp.Diff(tc.want, tc.parameters); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateProtectRepositoryTagsOptions(t *testing.T) {
+	cases := map[string]struct {
+		parameters *v1alpha1.ProtectedTagParameters
+		want       *ProtectRepositoryTagsOptions
+	}{
+		"RoleOnly": {
+			parameters: &v1alpha1.ProtectedTagParameters{
+				Name:               "v*",
+				CreateAccessLevels: []v1alpha1.TagAccessLevel{{AccessLevel: ptr.To(v1alpha1.AccessLevelValue(40))}},
+			},
+			want: &ProtectRepositoryTagsOptions{
+				Name:              gitlab.String("v*"),
+				CreateAccessLevel: gitlab.AccessLevel(gitlab.MaintainerPermissions),
+			},
+		},
+		"DeployKeyOnly": {
+			parameters: &v1alpha1.ProtectedTagParameters{
+				Name:               "v*",
+				CreateAccessLevels: []v1alpha1.TagAccessLevel{{DeployKeyID: ptr.To(3)}},
+			},
+			want: &ProtectRepositoryTagsOptions{
+				Name:              gitlab.String("v*"),
+				CreateAccessLevel: gitlab.AccessLevel(gitlab.NoPermissions),
+				AllowedToCreate:   &[]*TagPermissionOptions{{DeployKeyID: gitlab.Int(3)}},
+			},
+		},
+		"AllowedToCreate": {
+			parameters: &v1alpha1.ProtectedTagParameters{
+				Name: "v*",
+				CreateAccessLevels: []v1alpha1.TagAccessLevel{
+					{DeployKeyID: ptr.To(3)},
+					{AccessLevel: ptr.To(v1alpha1.AccessLevelValue(40))},
+					{AccessLevel: ptr.To(v1alpha1.AccessLevelValue(30))},
+					{GroupID: ptr.To(6)},
+				},
+			},
+			want: &ProtectRepositoryTagsOptions{
+				Name:              gitlab.String("v*"),
+				CreateAccessLevel: gitlab.AccessLevel(gitlab.MaintainerPermissions),
+				AllowedToCreate: &[]*TagPermissionOptions{
+					{DeployKeyID: gitlab.Int(3)},
+					{AccessLevel: gitlab.AccessLevel(gitlab.DeveloperPermissions)},
+					{GroupID: gitlab.Int(6)},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateProtectRepositoryTagsOptions(tc.parameters)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsProtectedTagUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		parameters *v1alpha1.ProtectedTagParameters
+		pt         *ProtectedTag
+		want       bool
+	}{
+		"UpToDate": {
+			parameters: &v1alpha1.ProtectedTagParameters{
+				CreateAccessLevels: []v1alpha1.TagAccessLevel{
+					{DeployKeyID: ptr.To(3)},
+					{AccessLevel: ptr.To(v1alpha1.AccessLevelValue(40))},
+				},
+			},
+			pt: &ProtectedTag{
+				CreateAccessLevels: []*TagAccessDescription{tagRoleAccess(gitlab.MaintainerPermissions), tagDeployKeyAccess(3)},
+			},
+			want: true,
+		},
+		"DeployKeyOnlyIgnoresRole": {
+			parameters: &v1alpha1.ProtectedTagParameters{
+				CreateAccessLevels: []v1alpha1.TagAccessLevel{{DeployKeyID: ptr.To(3)}},
+			},
+			pt: &ProtectedTag{
+				CreateAccessLevels: []*TagAccessDescription{tagRoleAccess(gitlab.MaintainerPermissions), tagDeployKeyAccess(3)},
+			},
+			want: true,
+		},
+		"DeployKeyOnlyChangedKey": {
+			parameters: &v1alpha1.ProtectedTagParameters{
+				CreateAccessLevels: []v1alpha1.TagAccessLevel{{DeployKeyID: ptr.To(4)}},
+			},
+			pt: &ProtectedTag{
+				CreateAccessLevels: []*TagAccessDescription{tagRoleAccess(gitlab.MaintainerPermissions), tagDeployKeyAccess(3)},
+			},
+			want: false,
+		},
+		"ChangedRole": {
+			parameters: &v1alpha1.ProtectedTagParameters{
+				CreateAccessLevels: []v1alpha1.TagAccessLevel{{AccessLevel: ptr.To(v1alpha1.AccessLevelValue(30))}},
+			},
+			pt: &ProtectedTag{
+				CreateAccessLevels: []*TagAccessDescription{tagRoleAccess(gitlab.MaintainerPermissions)},
+			},
+			want: false,
+		},
+		"MissingDeployKey": {
+			parameters: &v1alpha1.ProtectedTagParameters{
+				CreateAccessLevels: []v1alpha1.TagAccessLevel{
+					{AccessLevel: ptr.To(v1alpha1.AccessLevelValue(40))},
+					{DeployKeyID: ptr.To(3)},
+				},
+			},
+			pt: &ProtectedTag{
+				CreateAccessLevels: []*TagAccessDescription{tagRoleAccess(gitlab.MaintainerPermissions)},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsProtectedTagUpToDate(tc.parameters, tc.pt)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
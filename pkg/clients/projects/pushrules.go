@@ -0,0 +1,118 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// PushRulesClient defines Gitlab project push rule service operations
+type PushRulesClient interface {
+	GetProjectPushRules(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error)
+	AddProjectPushRule(pid interface{}, opt *gitlab.AddProjectPushRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error)
+	EditProjectPushRule(pid interface{}, opt *gitlab.EditProjectPushRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error)
+	DeleteProjectPushRule(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewPushRulesClient returns a new Gitlab project push rule service
+func NewPushRulesClient(cfg clients.Config) PushRulesClient {
+	git := clients.NewClient(cfg)
+	return git.Projects
+}
+
+// GeneratePushRulesObservation is used to produce v1alpha1.PushRulesObservation
+// from gitlab.ProjectPushRules.
+func GeneratePushRulesObservation(r *gitlab.ProjectPushRules) v1alpha1.PushRulesObservation {
+	if r == nil {
+		return v1alpha1.PushRulesObservation{}
+	}
+
+	return v1alpha1.PushRulesObservation{
+		ID:        &r.ID,
+		CreatedAt: clients.TimeToMetaTime(r.CreatedAt),
+	}
+}
+
+// LateInitializePushRules fills the empty fields in the push rules spec with
+// the values seen in gitlab.ProjectPushRules.
+func LateInitializePushRules(in *v1alpha1.PushRulesParameters, r *gitlab.ProjectPushRules) {
+	if r == nil {
+		return
+	}
+
+	in.CommitMessageRegex = clients.LateInitializeStringPtr(in.CommitMessageRegex, r.CommitMessageRegex)
+	in.CommitMessageNegativeRegex = clients.LateInitializeStringPtr(in.CommitMessageNegativeRegex, r.CommitMessageNegativeRegex)
+	in.BranchNameRegex = clients.LateInitializeStringPtr(in.BranchNameRegex, r.BranchNameRegex)
+	in.AuthorEmailRegex = clients.LateInitializeStringPtr(in.AuthorEmailRegex, r.AuthorEmailRegex)
+	in.FileNameRegex = clients.LateInitializeStringPtr(in.FileNameRegex, r.FileNameRegex)
+
+	if in.MaxFileSize == nil {
+		in.MaxFileSize = &r.MaxFileSize
+	}
+	if in.DenyDeleteTag == nil {
+		in.DenyDeleteTag = &r.DenyDeleteTag
+	}
+	if in.MemberCheck == nil {
+		in.MemberCheck = &r.MemberCheck
+	}
+	if in.PreventSecrets == nil {
+		in.PreventSecrets = &r.PreventSecrets
+	}
+	if in.CommitCommitterCheck == nil {
+		in.CommitCommitterCheck = &r.CommitCommitterCheck
+	}
+	if in.RejectUnsignedCommits == nil {
+		in.RejectUnsignedCommits = &r.RejectUnsignedCommits
+	}
+}
+
+// GenerateAddPushRuleOptions generates push rule creation options
+func GenerateAddPushRuleOptions(p *v1alpha1.PushRulesParameters) *gitlab.AddProjectPushRuleOptions {
+	return &gitlab.AddProjectPushRuleOptions{
+		AuthorEmailRegex:           p.AuthorEmailRegex,
+		BranchNameRegex:            p.BranchNameRegex,
+		CommitCommitterCheck:       p.CommitCommitterCheck,
+		CommitMessageNegativeRegex: p.CommitMessageNegativeRegex,
+		CommitMessageRegex:         p.CommitMessageRegex,
+		DenyDeleteTag:              p.DenyDeleteTag,
+		FileNameRegex:              p.FileNameRegex,
+		MaxFileSize:                p.MaxFileSize,
+		MemberCheck:                p.MemberCheck,
+		PreventSecrets:             p.PreventSecrets,
+		RejectUnsignedCommits:      p.RejectUnsignedCommits,
+	}
+}
+
+// GenerateEditPushRuleOptions generates push rule update options
+func GenerateEditPushRuleOptions(p *v1alpha1.PushRulesParameters) *gitlab.EditProjectPushRuleOptions {
+	return &gitlab.EditProjectPushRuleOptions{
+		AuthorEmailRegex:           p.AuthorEmailRegex,
+		BranchNameRegex:            p.BranchNameRegex,
+		CommitCommitterCheck:       p.CommitCommitterCheck,
+		CommitMessageNegativeRegex: p.CommitMessageNegativeRegex,
+		CommitMessageRegex:         p.CommitMessageRegex,
+		DenyDeleteTag:              p.DenyDeleteTag,
+		FileNameRegex:              p.FileNameRegex,
+		MaxFileSize:                p.MaxFileSize,
+		MemberCheck:                p.MemberCheck,
+		PreventSecrets:             p.PreventSecrets,
+		RejectUnsignedCommits:      p.RejectUnsignedCommits,
+	}
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestGeneratePushRulesObservation(t *testing.T) {
+	created := time.Now()
+
+	cases := map[string]struct {
+		rules *gitlab.ProjectPushRules
+		want  v1alpha1.PushRulesObservation
+	}{
+		"Full": {
+			rules: &gitlab.ProjectPushRules{ID: 1, CreatedAt: &created},
+			want:  v1alpha1.PushRulesObservation{ID: ptr.To(1), CreatedAt: &metav1.Time{Time: created}},
+		},
+		"Nil": {
+			want: v1alpha1.PushRulesObservation{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GeneratePushRulesObservation(tc.rules)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializePushRules(t *testing.T) {
+	cases := map[string]struct {
+		parameters *v1alpha1.PushRulesParameters
+		rules      *gitlab.ProjectPushRules
+		want       *v1alpha1.PushRulesParameters
+	}{
+		"AllOptionalFields": {
+			parameters: &v1alpha1.PushRulesParameters{},
+			rules: &gitlab.ProjectPushRules{
+				CommitMessageRegex: "^JIRA-",
+				FileNameRegex:      "(jar|exe)$",
+				MaxFileSize:        100,
+				DenyDeleteTag:      true,
+				PreventSecrets:     true,
+			},
+			want: &v1alpha1.PushRulesParameters{
+				CommitMessageRegex:    ptr.To("^JIRA-"),
+				FileNameRegex:         ptr.To("(jar|exe)$"),
+				MaxFileSize:           ptr.To(100),
+				DenyDeleteTag:         ptr.To(true),
+				MemberCheck:           ptr.To(false),
+				PreventSecrets:        ptr.To(true),
+				CommitCommitterCheck:  ptr.To(false),
+				RejectUnsignedCommits: ptr.To(false),
+			},
+		},
+		"SomeFieldsDontOverwrite": {
+			parameters: &v1alpha1.PushRulesParameters{
+				CommitMessageRegex: ptr.To(""),
+				MaxFileSize:        ptr.To(0),
+				DenyDeleteTag:      ptr.To(false),
+			},
+			rules: &gitlab.ProjectPushRules{
+				CommitMessageRegex: "^JIRA-",
+				MaxFileSize:        100,
+				DenyDeleteTag:      true,
+			},
+			want: &v1alpha1.PushRulesParameters{
+				CommitMessageRegex:    ptr.To(""),
+				MaxFileSize:           ptr.To(0),
+				DenyDeleteTag:         ptr.To(false),
+				MemberCheck:           ptr.To(false),
+				PreventSecrets:        ptr.To(false),
+				CommitCommitterCheck:  ptr.To(false),
+				RejectUnsignedCommits: ptr.To(false),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializePushRules(tc.parameters, tc.rules)
+			if diff := cmp.Diff(tc.want, tc.parameters); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratePushRuleOptions(t *testing.T) {
+	p := &v1alpha1.PushRulesParameters{
+		CommitMessageRegex:         ptr.To("^JIRA-"),
+		CommitMessageNegativeRegex: ptr.To("ssh://"),
+		BranchNameRegex:            ptr.To("^(feature|hotfix)/"),
+		AuthorEmailRegex:           ptr.To("@example.com$"),
+		FileNameRegex:              ptr.To("(jar|exe)$"),
+		MaxFileSize:                ptr.To(100),
+		DenyDeleteTag:              ptr.To(true),
+		MemberCheck:                ptr.To(true),
+		PreventSecrets:             ptr.To(true),
+		CommitCommitterCheck:       ptr.To(true),
+		RejectUnsignedCommits:      ptr.To(true),
+	}
+
+	wantAdd := &gitlab.AddProjectPushRuleOptions{
+		CommitMessageRegex:         p.CommitMessageRegex,
+		CommitMessageNegativeRegex: p.CommitMessageNegativeRegex,
+		BranchNameRegex:            p.BranchNameRegex,
+		AuthorEmailRegex:           p.AuthorEmailRegex,
+		FileNameRegex:              p.FileNameRegex,
+		MaxFileSize:                p.MaxFileSize,
+		DenyDeleteTag:              p.DenyDeleteTag,
+		MemberCheck:                p.MemberCheck,
+		PreventSecrets:             p.PreventSecrets,
+		CommitCommitterCheck:       p.CommitCommitterCheck,
+		RejectUnsignedCommits:      p.RejectUnsignedCommits,
+	}
+	if diff := cmp.Diff(wantAdd, GenerateAddPushRuleOptions(p)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+
+	wantEdit := (*gitlab.EditProjectPushRuleOptions)(wantAdd)
+	if diff := cmp.Diff(wantEdit, GenerateEditPushRuleOptions(p)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
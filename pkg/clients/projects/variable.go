@@ -17,8 +17,6 @@ limitations under the License.
 package projects
 
 import (
-	"strings"
-
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -28,10 +26,6 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 )
 
-const (
-	errVariableNotFound = "404 Variable Not Found"
-)
-
 // VariableClient defines Gitlab Variable service operations
 type VariableClient interface {
 	ListVariables(pid interface{}, opt *gitlab.ListProjectVariablesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectVariable, *gitlab.Response, error)
@@ -47,12 +41,12 @@ func NewVariableClient(cfg clients.Config) VariableClient {
 	return git.ProjectVariables
 }
 
-// IsErrorVariableNotFound helper function to test for errProjectNotFound error.
+// IsErrorVariableNotFound returns true if the supplied error reports a missing project variable.
+//
+// Deprecated: Use clients.IsNotFound, which does not depend on the error
+// message.
 func IsErrorVariableNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	return strings.Contains(err.Error(), errVariableNotFound)
+	return clients.IsNotFound(err)
 }
 
 // LateInitializeVariable fills the empty fields in the projecthook spec with the
@@ -0,0 +1,197 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+const (
+	headerRateLimitRemaining = "RateLimit-Remaining"
+	headerRateLimitReset     = "RateLimit-Reset"
+	headerRetryAfter         = "Retry-After"
+)
+
+// Condition types and reasons of rate limited managed resources.
+const (
+	TypeRateLimited xpv1.ConditionType = "RateLimited"
+
+	ReasonRateLimited    xpv1.ConditionReason = "RateLimited"
+	ReasonNotRateLimited xpv1.ConditionReason = "NotRateLimited"
+)
+
+// MaxRateLimitWait is the longest a request waits for the rate limit of a
+// Gitlab instance to reset. Requests that would have to wait longer fail with
+// a RateLimitedError instead of blocking a reconcile.
+var MaxRateLimitWait = 10 * time.Second
+
+// A RateLimitedError is returned for requests that were not sent because the
+// rate limit of the Gitlab instance is exhausted.
+type RateLimitedError struct {
+	Host       string
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitedError) Error() string {
+	return fmt.Sprintf("rate limited by %s, retry after %s", e.Host, e.RetryAfter.Round(time.Second))
+}
+
+// IsRateLimited returns true if the supplied error was caused by an exhausted
+// Gitlab rate limit.
+func IsRateLimited(err error) bool {
+	return KindOf(err) == ErrorKindRateLimited
+}
+
+// RateLimited returns a condition that indicates the managed resource could
+// not be reconciled because the Gitlab rate limit is exhausted.
+func RateLimited(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeRateLimited,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRateLimited,
+		Message:            err.Error(),
+	}
+}
+
+// NotRateLimited returns a condition that indicates the requests of the last
+// reconcile were served by Gitlab.
+func NotRateLimited() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeRateLimited,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNotRateLimited,
+	}
+}
+
+// rateLimits holds the rate limit state of every Gitlab instance, keyed by
+// scheme and host, so that all controllers back off together.
+var rateLimits = struct {
+	sync.Mutex
+	m map[string]*rateLimitState
+}{m: map[string]*rateLimitState{}}
+
+func rateLimitFor(host string) *rateLimitState {
+	rateLimits.Lock()
+	defer rateLimits.Unlock()
+	s, ok := rateLimits.m[host]
+	if !ok {
+		s = &rateLimitState{}
+		rateLimits.m[host] = s
+	}
+	return s
+}
+
+// rateLimitState tracks until when no requests should be sent to a Gitlab
+// instance.
+type rateLimitState struct {
+	mu    sync.Mutex
+	until time.Time
+}
+
+func (s *rateLimitState) blockedUntil() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.until
+}
+
+// update blocks requests until the rate limit reset announced by the
+// supplied response, if the response exhausted the rate limit or was
+// rejected by it.
+func (s *rateLimitState) update(res *http.Response, now time.Time) {
+	limited := res.StatusCode == http.StatusTooManyRequests
+	var until time.Time
+	if limited {
+		if d, ok := parseRetryAfter(res.Header.Get(headerRetryAfter), now); ok {
+			until = now.Add(d)
+		}
+	}
+	if until.IsZero() && (limited || res.Header.Get(headerRateLimitRemaining) == "0") {
+		if reset, err := strconv.ParseInt(res.Header.Get(headerRateLimitReset), 10, 64); err == nil {
+			until = time.Unix(reset, 0)
+		}
+	}
+	if until.IsZero() {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if until.After(s.until) {
+		s.until = until
+	}
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as
+// an HTTP date.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if s, err := strconv.Atoi(v); err == nil {
+		return time.Duration(s) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return t.Sub(now), true
+	}
+	return 0, false
+}
+
+// rateLimitTransport delays requests to Gitlab instances whose rate limit is
+// exhausted until it resets.
+type rateLimitTransport struct {
+	base http.RoundTripper
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	s := rateLimitFor(req.URL.Scheme + "://" + req.URL.Host)
+	if wait := time.Until(s.blockedUntil()); wait > 0 {
+		if wait > MaxRateLimitWait {
+			return nil, &RateLimitedError{Host: req.URL.Host, RetryAfter: wait}
+		}
+		if err := sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+	res, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	s.update(res, time.Now())
+	return res, nil
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestRateLimitTransport(t *testing.T) {
+	cases := map[string]struct {
+		header      http.Header
+		status      int
+		wantLimited bool
+	}{
+		"NotLimited": {
+			header: http.Header{headerRateLimitRemaining: []string{"10"}},
+			status: http.StatusOK,
+		},
+		"RetryAfter": {
+			header:      http.Header{headerRetryAfter: []string{"60"}},
+			status:      http.StatusTooManyRequests,
+			wantLimited: true,
+		},
+		"RateLimitExhausted": {
+			header: http.Header{
+				headerRateLimitRemaining: []string{"0"},
+				headerRateLimitReset:     []string{strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10)},
+			},
+			status:      http.StatusOK,
+			wantLimited: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if calls == 1 {
+					for k, v := range tc.header {
+						w.Header()[k] = v
+					}
+					w.WriteHeader(tc.status)
+				}
+			}))
+			defer srv.Close()
+
+			hc := &http.Client{Transport: &rateLimitTransport{base: http.DefaultTransport}}
+			res, err := hc.Get(srv.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res.Body.Close()
+
+			res, err = hc.Get(srv.URL)
+			if res != nil {
+				res.Body.Close()
+			}
+			if diff := cmp.Diff(tc.wantLimited, IsRateLimited(err)); diff != "" {
+				t.Errorf("IsRateLimited(...): -want, +got:\n%s\n%v", diff, err)
+			}
+			wantCalls := 2
+			if tc.wantLimited {
+				wantCalls = 1
+			}
+			if diff := cmp.Diff(wantCalls, calls); diff != "" {
+				t.Errorf("calls: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRateLimitTransportWaits(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set(headerRetryAfter, "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer srv.Close()
+
+	hc := &http.Client{Transport: &rateLimitTransport{base: http.DefaultTransport}}
+	res, err := hc.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	start := time.Now()
+	res, err = hc.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if waited := time.Since(start); waited < 500*time.Millisecond {
+		t.Errorf("want request to wait for the rate limit to reset, waited %s", waited)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		v    string
+		want time.Duration
+		ok   bool
+	}{
+		"Empty":   {v: "", ok: false},
+		"Seconds": {v: "30", want: 30 * time.Second, ok: true},
+		"Date":    {v: now.Add(time.Minute).Format(http.TimeFormat), want: time.Minute, ok: true},
+		"Invalid": {v: "soon", ok: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, ok := parseRetryAfter(tc.v, now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.ok, ok); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRateLimitExternal(t *testing.T) {
+	errRateLimited := &RateLimitedError{Host: "gitlab.com", RetryAfter: time.Minute}
+	req, _ := http.NewRequest(http.MethodGet, "https://gitlab.com/api/v4/projects/1", nil)
+	errTooManyRequests := &gitlab.ErrorResponse{Response: &http.Response{StatusCode: http.StatusTooManyRequests, Request: req}}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		err  error
+		want corev1.ConditionStatus
+	}{
+		"RateLimited": {
+			mg:   &v1alpha1.Project{},
+			err:  errors.Wrap(errRateLimited, "cannot get project"),
+			want: corev1.ConditionTrue,
+		},
+		"TooManyRequests": {
+			mg:   &v1alpha1.Project{},
+			err:  errTooManyRequests,
+			want: corev1.ConditionTrue,
+		},
+		"OtherError": {
+			mg:   &v1alpha1.Project{},
+			err:  errors.New("boom"),
+			want: corev1.ConditionUnknown,
+		},
+		"Recovered": {
+			mg: func() resource.Managed {
+				p := &v1alpha1.Project{}
+				p.SetConditions(RateLimited(errRateLimited))
+				return p
+			}(),
+			want: corev1.ConditionFalse,
+		},
+		"NeverLimited": {
+			mg:   &v1alpha1.Project{},
+			want: corev1.ConditionUnknown,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &errorExternal{
+				ExternalClient: &managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						return managed.ExternalObservation{}, tc.err
+					},
+				},
+				record:   event.NewNopRecorder(),
+				terminal: &terminalErrors{m: map[types.UID]terminalError{}},
+			}
+			_, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.mg.GetCondition(TypeRateLimited).Status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+)
+
+const (
+	errGetCABundle       = "cannot get CA bundle"
+	errGetClientCert     = "cannot get client certificate"
+	errGetClientKey      = "cannot get client key"
+	errNoCACertificates  = "CA bundle does not contain any PEM encoded certificate"
+	errClientKeyPair     = "cannot load client certificate and key"
+	errIncompleteKeyPair = "clientCertSecretRef and clientKeySecretRef must be set together"
+)
+
+// useTLS resolves the TLS secrets referenced by the supplied ProviderConfig
+// into cfg.
+func useTLS(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, cfg *Config) error {
+	if (pc.Spec.ClientCertSecretRef == nil) != (pc.Spec.ClientKeySecretRef == nil) {
+		return errors.New(errIncompleteKeyPair)
+	}
+	var err error
+	if ref := pc.Spec.CABundleSecretRef; ref != nil {
+		if cfg.CABundle, err = getSecretValue(ctx, c, ref); err != nil {
+			return errors.Wrap(err, errGetCABundle)
+		}
+	}
+	if ref := pc.Spec.ClientCertSecretRef; ref != nil {
+		if cfg.ClientCert, err = getSecretValue(ctx, c, ref); err != nil {
+			return errors.Wrap(err, errGetClientCert)
+		}
+	}
+	if ref := pc.Spec.ClientKeySecretRef; ref != nil {
+		if cfg.ClientKey, err = getSecretValue(ctx, c, ref); err != nil {
+			return errors.Wrap(err, errGetClientKey)
+		}
+	}
+	return nil
+}
+
+// newTLSConfig builds the TLS configuration used to connect to Gitlab. The CA
+// bundle, if any, is trusted in addition to the system roots.
+func newTLSConfig(c Config) (*tls.Config, error) {
+	tc := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: c.InsecureSkipVerify, //nolint:gosec // explicitly requested by the ProviderConfig
+	}
+	if len(c.CABundle) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(c.CABundle) {
+			return nil, errors.New(errNoCACertificates)
+		}
+		tc.RootCAs = pool
+	}
+	if len(c.ClientCert) > 0 || len(c.ClientKey) > 0 {
+		cert, err := tls.X509KeyPair(c.ClientCert, c.ClientKey)
+		if err != nil {
+			return nil, errors.Wrap(err, errClientKeyPair)
+		}
+		tc.Certificates = []tls.Certificate{cert}
+	}
+	return tc, nil
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestNewTLSConfig(t *testing.T) {
+	var clientCerts int
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientCerts = len(r.TLS.PeerCertificates)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":1}`))
+	}))
+	srv.TLS = &tls.Config{ClientAuth: tls.RequestClientCert, MinVersion: tls.VersionTLS12}
+	srv.StartTLS()
+	defer srv.Close()
+
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	clientCert, clientKey := newTestKeyPair(t)
+
+	type want struct {
+		err         error
+		callErr     bool
+		clientCerts int
+	}
+
+	cases := map[string]struct {
+		cfg  Config
+		want want
+	}{
+		"UntrustedServer": {
+			cfg:  Config{},
+			want: want{callErr: true},
+		},
+		"CABundle": {
+			cfg:  Config{CABundle: caBundle},
+			want: want{},
+		},
+		"InsecureSkipVerify": {
+			cfg:  Config{InsecureSkipVerify: true},
+			want: want{},
+		},
+		"MutualTLS": {
+			cfg:  Config{CABundle: caBundle, ClientCert: clientCert, ClientKey: clientKey},
+			want: want{clientCerts: 1},
+		},
+		"InvalidCABundle": {
+			cfg:  Config{CABundle: []byte("not a certificate")},
+			want: want{err: errors.New(errNoCACertificates)},
+		},
+		"ClientCertWithoutKey": {
+			cfg:  Config{ClientCert: clientCert},
+			want: want{err: errors.Wrap(errors.New("tls: failed to find any PEM data in key input"), errClientKeyPair)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			clientCerts = 0
+			tlsConfig, err := newTLSConfig(tc.cfg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("r: -want, +got:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			hc := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+			cl, err := gitlab.NewClient("", gitlab.WithBaseURL(srv.URL), gitlab.WithHTTPClient(hc), gitlab.WithCustomRetryMax(0))
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, _, err = cl.Users.CurrentUser()
+			if diff := cmp.Diff(tc.want.callErr, err != nil); diff != "" {
+				t.Errorf("r: -want, +got:\n%s\n%v", diff, err)
+			}
+			if diff := cmp.Diff(tc.want.clientCerts, clientCerts); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func newTestKeyPair(t *testing.T) (cert []byte, key []byte) {
+	t.Helper()
+	k, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "provider-gitlab"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &k.PublicKey, k)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kder, err := x509.MarshalECPrivateKey(k)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: kder})
+}
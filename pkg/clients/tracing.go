@@ -0,0 +1,76 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
+)
+
+// rateLimitHeaders are the response headers in which Gitlab reports the state
+// of its rate limit. They are recorded on the span of every request.
+var rateLimitHeaders = map[string]attribute.Key{
+	"RateLimit-Limit":     "gitlab.ratelimit.limit",
+	"RateLimit-Remaining": "gitlab.ratelimit.remaining",
+	"RateLimit-Reset":     "gitlab.ratelimit.reset",
+	"Retry-After":         "gitlab.ratelimit.retry_after",
+}
+
+// tracingTransport records every request to Gitlab as a span that is a child
+// of the span in the context of the request, if any.
+type tracingTransport struct {
+	base http.RoundTripper
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	endpoint := endpointTemplate(req.URL.EscapedPath())
+	ctx, span := tracing.Tracer().Start(req.Context(), req.Method+" "+endpoint,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.method", req.Method),
+			attribute.String("gitlab.endpoint", endpoint),
+			attribute.String("server.address", req.URL.Host),
+		))
+	defer span.End()
+
+	req = req.Clone(ctx)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	res, err := t.base.RoundTrip(req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return res, err
+	}
+	span.SetAttributes(attribute.Int("http.status_code", res.StatusCode))
+	for h, k := range rateLimitHeaders {
+		if v := res.Header.Get(h); v != "" {
+			span.SetAttributes(k.String(v))
+		}
+	}
+	if res.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, res.Status)
+	}
+	return res, nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracingTransport(t *testing.T) {
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+	prevTP, prevProp := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer func() {
+		otel.SetTracerProvider(prevTP)
+		otel.SetTextMapPropagator(prevProp)
+	}()
+
+	var traceparent string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		w.Header().Set("RateLimit-Remaining", "42")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/api/v4/projects/42", nil)
+	rt := &tracingTransport{base: http.DefaultTransport}
+	res, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	parent.End()
+
+	spans := sr.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("want 2 spans, got %d", len(spans))
+	}
+	s := spans[0]
+	if diff := cmp.Diff("GET /projects/:id", s.Name()); diff != "" {
+		t.Errorf("name: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(parent.SpanContext().SpanID(), s.Parent().SpanID()); diff != "" {
+		t.Errorf("parent: -want, +got:\n%s", diff)
+	}
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, a := range s.Attributes() {
+		attrs[a.Key] = a.Value
+	}
+	if diff := cmp.Diff(int64(http.StatusNotFound), attrs["http.status_code"].AsInt64()); diff != "" {
+		t.Errorf("status: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("42", attrs["gitlab.ratelimit.remaining"].AsString()); diff != "" {
+		t.Errorf("ratelimit: -want, +got:\n%s", diff)
+	}
+	if traceparent == "" {
+		t.Error("traceparent header was not propagated to Gitlab")
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/pkg/errors"
+	"golang.org/x/net/http/httpproxy"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+)
+
+const (
+	errInvalidProxyURL = "invalid proxyURL"
+	errGetExtraHeader  = "cannot get value of extra header %s"
+)
+
+// useTransport resolves the proxy settings and extra headers of the supplied
+// ProviderConfig into cfg and checks that a transport can be built from them.
+func useTransport(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, cfg *Config) error {
+	if pc.Spec.ProxyURL != nil {
+		cfg.ProxyURL = *pc.Spec.ProxyURL
+	}
+	if pc.Spec.NoProxy != nil {
+		cfg.NoProxy = *pc.Spec.NoProxy
+	}
+	if len(pc.Spec.ExtraHeaders) > 0 {
+		cfg.ExtraHeaders = http.Header{}
+		for _, h := range pc.Spec.ExtraHeaders {
+			v, err := getSecretValue(ctx, c, &h.ValueSecretRef)
+			if err != nil {
+				return errors.Wrapf(err, errGetExtraHeader, h.Name)
+			}
+			cfg.ExtraHeaders.Add(h.Name, string(v))
+		}
+	}
+	_, err := NewTransport(*cfg)
+	return err
+}
+
+// NewTransport builds the HTTP transport for all requests to Gitlab described
+// by the supplied Config, including requests to its OAuth2 token endpoint.
+// Requests are delayed while the rate limit of the Gitlab instance is
+// exhausted, their count and latency are recorded as metrics and they are
+// traced as children of the span in their context.
+func NewTransport(c Config) (http.RoundTripper, error) {
+	tlsConfig, err := newTLSConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	transport := cleanhttp.DefaultPooledTransport()
+	transport.TLSClientConfig = tlsConfig
+
+	if c.ProxyURL != "" || c.NoProxy != "" {
+		pc := httpproxy.FromEnvironment()
+		if c.ProxyURL != "" {
+			u, err := url.Parse(c.ProxyURL)
+			if err != nil || u.Scheme == "" || u.Host == "" {
+				return nil, errors.Errorf("%s: %q", errInvalidProxyURL, c.ProxyURL)
+			}
+			pc.HTTPProxy = c.ProxyURL
+			pc.HTTPSProxy = c.ProxyURL
+		}
+		if c.NoProxy != "" {
+			pc.NoProxy = c.NoProxy
+		}
+		proxy := pc.ProxyFunc()
+		transport.Proxy = func(req *http.Request) (*url.URL, error) {
+			return proxy(req.URL)
+		}
+	}
+
+	var rt http.RoundTripper = transport
+	if len(c.ExtraHeaders) > 0 {
+		rt = &headerTransport{base: rt, header: c.ExtraHeaders}
+	}
+	rt = &metricsTransport{base: rt, providerConfig: c.providerConfig}
+	return &tracingTransport{base: &rateLimitTransport{base: rt}}, nil
+}
+
+// headerTransport adds a fixed set of headers to every request.
+type headerTransport struct {
+	base   http.RoundTripper
+	header http.Header
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for k, v := range t.header {
+		req.Header[k] = v
+	}
+	return t.base.RoundTrip(req)
+}
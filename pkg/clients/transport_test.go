@@ -0,0 +1,110 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestNewTransportProxy(t *testing.T) {
+	t.Setenv("HTTPS_PROXY", "")
+	t.Setenv("HTTP_PROXY", "")
+	t.Setenv("NO_PROXY", "")
+
+	type want struct {
+		proxy string
+		err   error
+	}
+
+	cases := map[string]struct {
+		cfg    Config
+		target string
+		want   want
+	}{
+		"NoProxy": {
+			cfg:    Config{},
+			target: "https://gitlab.example.com/api/v4/user",
+			want:   want{},
+		},
+		"Proxy": {
+			cfg:    Config{ProxyURL: "http://proxy.example.com:3128"},
+			target: "https://gitlab.example.com/api/v4/user",
+			want:   want{proxy: "http://proxy.example.com:3128"},
+		},
+		"Excluded": {
+			cfg:    Config{ProxyURL: "http://proxy.example.com:3128", NoProxy: "internal.example.com,.corp"},
+			target: "https://gitlab.corp/api/v4/user",
+			want:   want{},
+		},
+		"InvalidProxyURL": {
+			cfg:  Config{ProxyURL: "proxy.example.com"},
+			want: want{err: errors.Errorf("%s: %q", errInvalidProxyURL, "proxy.example.com")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rt, err := NewTransport(tc.cfg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("r: -want, +got:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			req, _ := http.NewRequest(http.MethodGet, tc.target, nil)
+			u, err := rt.(*tracingTransport).base.(*rateLimitTransport).base.(*metricsTransport).base.(*http.Transport).Proxy(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := ""
+			if u != nil {
+				got = u.String()
+			}
+			if diff := cmp.Diff(tc.want.proxy, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewTransportExtraHeaders(t *testing.T) {
+	var got http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+	}))
+	defer srv.Close()
+
+	rt, err := NewTransport(Config{ExtraHeaders: http.Header{"X-Gateway-Token": []string{"secret"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := (&http.Client{Transport: rt}).Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if diff := cmp.Diff("secret", got.Get("X-Gateway-Token")); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,393 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	gitlab "github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/providerconfig"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
+)
+
+const (
+	errGetProviderConfig = "cannot get ProviderConfig"
+	errUpdateStatus      = "cannot update ProviderConfig status"
+	errGetCurrentUser    = "cannot get current user"
+	errGetToken          = "cannot get access token"
+	errMissingScope      = "access token lacks the %s scope"
+	errTokenExpiresSoon  = "access token expires on %s"
+	errRotateToken       = "cannot rotate access token"
+	errWriteToken        = "cannot write rotated access token to credentials secret"
+	errWroteFallback     = "cannot write rotated access token to credentials secret, wrote it to secret %s/%s instead"
+	errGetFallback       = "cannot get secret %s/%s holding the rotated access token"
+	errRotationSource    = "token rotation requires the Secret credentials source and the personal authMethod"
+
+	healthCheckTimeout = 1 * time.Minute
+
+	// requiredScope is the token scope needed to manage resources.
+	requiredScope = "api"
+
+	// tokenExpiryWarning is how long before its expiry a token is reported
+	// as expiring soon.
+	tokenExpiryWarning = 7 * 24 * time.Hour
+
+	// defaultRotateBefore is how long before its expiry a token is rotated
+	// if the ProviderConfig does not specify it.
+	defaultRotateBefore = 72 * time.Hour
+
+	reasonRotatedToken  event.Reason = "RotatedToken"
+	reasonRestoredToken event.Reason = "RestoredToken"
+)
+
+// writeBackoff is how often and how long the rotated access token is tried to
+// be written before giving up.
+var writeBackoff = wait.Backoff{
+	Steps:    6,
+	Duration: 200 * time.Millisecond,
+	Factor:   2,
+	Jitter:   0.1,
+}
+
+// Reasons of the Ready condition of a ProviderConfig.
+const (
+	ReasonUnauthorized      xpv1.ConditionReason = "Unauthorized"
+	ReasonTokenExpiringSoon xpv1.ConditionReason = "TokenExpiringSoon"
+	ReasonInsufficientScope xpv1.ConditionReason = "InsufficientScope"
+	ReasonUnreachable       xpv1.ConditionReason = "Unreachable"
+	ReasonInvalidConfig     xpv1.ConditionReason = "InvalidConfig"
+	ReasonRotationFailed    xpv1.ConditionReason = "RotationFailed"
+)
+
+// SetupHealth adds a controller that periodically checks the credentials of
+// ProviderConfigs against Gitlab and reports the result in their status.
+func SetupHealth(mgr ctrl.Manager, o controller.Options) error {
+	name := "health/" + providerconfig.ControllerName(v1beta1.ProviderConfigGroupKind)
+
+	log := o.Logger.WithValues("controller", name)
+	r := &healthReconciler{
+		kube:               mgr.GetClient(),
+		newHealthClientFn:  clients.NewHealthClient,
+		log:                log,
+		record:             event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+		pollInterval:       o.PollInterval,
+		tokenExpiryWarning: tokenExpiryWarning,
+		writeBackoff:       writeBackoff,
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1beta1.ProviderConfig{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(tracing.NewReconciler(r, v1beta1.ProviderConfigGroupKind, log))
+}
+
+type healthReconciler struct {
+	kube               client.Client
+	newHealthClientFn  func(cfg clients.Config) clients.HealthClient
+	log                logging.Logger
+	record             event.Recorder
+	pollInterval       time.Duration
+	tokenExpiryWarning time.Duration
+	writeBackoff       wait.Backoff
+}
+
+// Reconcile checks the credentials of a ProviderConfig and requeues it for
+// the next check after the poll interval.
+func (r *healthReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := tracing.WithTraceIDs(ctx, r.log).WithValues("request", req)
+	log.Debug("Reconciling")
+
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	pc := &v1beta1.ProviderConfig{}
+	if err := r.kube.Get(ctx, req.NamespacedName, pc); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetProviderConfig)
+	}
+	if meta.WasDeleted(pc) {
+		return reconcile.Result{}, nil
+	}
+
+	r.restore(ctx, pc)
+
+	var c xpv1.Condition
+	cfg, err := clients.ConfigFromProviderConfig(ctx, r.kube, pc)
+	if err != nil {
+		c = unavailable(ReasonInvalidConfig, err)
+	} else {
+		cl := r.newHealthClientFn(*cfg)
+		c = r.check(ctx, pc, cl, cfg.AuthMethod)
+		if pc.Spec.Rotation != nil && c.Reason == ReasonTokenExpiringSoon {
+			c = r.rotate(ctx, pc, cl, cfg.AuthMethod)
+		}
+	}
+
+	if c.Status != corev1.ConditionTrue || c.Reason != xpv1.ReasonAvailable {
+		r.record.Event(pc, event.Warning(event.Reason(c.Reason), errors.New(c.Message)))
+	}
+	pc.SetConditions(c)
+	pc.Status.LastCheckTime = ptr.To(metav1.Now())
+	return reconcile.Result{RequeueAfter: r.pollInterval}, errors.Wrap(r.kube.Status().Update(ctx, pc), errUpdateStatus)
+}
+
+// check queries the user, access token and version the supplied client
+// authenticates with, records them in the status of the supplied
+// ProviderConfig and returns its Ready condition.
+func (r *healthReconciler) check(ctx context.Context, pc *v1beta1.ProviderConfig, cl clients.HealthClient, method v1beta1.AuthMethod) xpv1.Condition {
+	// Job tokens are only accepted by a few CI related endpoints, so there is
+	// nothing to check them against.
+	if method == v1beta1.AuthMethodJob {
+		return xpv1.Available()
+	}
+
+	u, res, err := cl.CurrentUser(gitlab.WithContext(ctx))
+	if err != nil {
+		return responseError(res, errors.Wrap(err, errGetCurrentUser))
+	}
+	pc.Status.Username = u.Username
+
+	if v, _, err := cl.GetVersion(gitlab.WithContext(ctx)); err == nil {
+		pc.Status.Version = v.Version
+		pc.Status.Revision = v.Revision
+	}
+
+	// Only personal, group and project access tokens can be inspected.
+	if method != v1beta1.AuthMethodPersonal {
+		return xpv1.Available()
+	}
+
+	t, res, err := cl.GetSinglePersonalAccessToken(gitlab.WithContext(ctx))
+	if clients.IsResponseNotFound(res) {
+		// Gitlab before 15.5 cannot report on the token in use.
+		return xpv1.Available()
+	}
+	if err != nil {
+		return responseError(res, errors.Wrap(err, errGetToken))
+	}
+	pc.Status.TokenScopes = t.Scopes
+	pc.Status.TokenExpiresAt = nil
+	if t.ExpiresAt != nil {
+		pc.Status.TokenExpiresAt = &metav1.Time{Time: time.Time(*t.ExpiresAt)}
+	}
+
+	if !hasScope(t.Scopes, requiredScope) {
+		return unavailable(ReasonInsufficientScope, errors.Errorf(errMissingScope, requiredScope))
+	}
+	warning := r.tokenExpiryWarning
+	if rot := pc.Spec.Rotation; rot != nil {
+		// With rotation enabled an expiring token is only a concern once it
+		// is due for rotation.
+		warning = rotateBefore(rot)
+	}
+	if exp := pc.Status.TokenExpiresAt; exp != nil && time.Until(exp.Time) < warning {
+		c := xpv1.Available()
+		c.Reason = ReasonTokenExpiringSoon
+		c.Message = fmt.Sprintf(errTokenExpiresSoon, exp.Format(time.DateOnly))
+		return c
+	}
+	return xpv1.Available()
+}
+
+// rotate replaces the access token the supplied client authenticates with and
+// writes the new token into the credentials Secret of the supplied
+// ProviderConfig. It returns the Ready condition of the ProviderConfig.
+func (r *healthReconciler) rotate(ctx context.Context, pc *v1beta1.ProviderConfig, cl clients.HealthClient, method v1beta1.AuthMethod) xpv1.Condition {
+	ref := pc.Spec.Credentials.SecretRef
+	if pc.Spec.Credentials.Source != xpv1.CredentialsSourceSecret || ref == nil || method != v1beta1.AuthMethodPersonal {
+		return unavailable(ReasonRotationFailed, errors.New(errRotationSource))
+	}
+
+	opt := &clients.RotateSelfAccessTokenOptions{}
+	if d := pc.Spec.Rotation.ExpiresAfter; d != nil {
+		opt.ExpiresAt = ptr.To(gitlab.ISOTime(time.Now().Add(d.Duration)))
+	}
+	t, _, err := cl.RotateSelfAccessToken(opt, gitlab.WithContext(ctx))
+	if err != nil {
+		return unavailable(ReasonRotationFailed, errors.Wrap(err, errRotateToken))
+	}
+
+	pc.Status.TokenScopes = t.Scopes
+	pc.Status.TokenExpiresAt = nil
+	if t.ExpiresAt != nil {
+		pc.Status.TokenExpiresAt = &metav1.Time{Time: time.Time(*t.ExpiresAt)}
+	}
+	pc.Status.LastRotationTime = ptr.To(metav1.Now())
+
+	// Gitlab has revoked the old token at this point, so the new one must not
+	// get lost to a conflicting update of the Secret or a brief outage of the
+	// API server. If it cannot be written anyway it is kept in a new Secret
+	// next to the credentials Secret, which is used until the token could be
+	// written back.
+	if err := r.writeToken(ctx, ref, t.Token); err != nil {
+		name, ferr := r.writeFallback(ctx, ref, t.Token)
+		if ferr != nil {
+			return unavailable(ReasonRotationFailed, errors.Wrap(err, errWriteToken))
+		}
+		r.deleteFallback(ctx, pc)
+		pc.Status.RotatedCredentialsSecretRef = &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Namespace: ref.Namespace, Name: name},
+			Key:             ref.Key,
+		}
+		return unavailable(ReasonRotationFailed, errors.Wrapf(err, errWroteFallback, ref.Namespace, name))
+	}
+	r.deleteFallback(ctx, pc)
+	r.record.Event(pc, event.Normal(reasonRotatedToken, "Rotated access token"))
+	return xpv1.Available()
+}
+
+// restore writes an access token that was rotated but could only be written
+// into a fallback Secret back into the credentials Secret of the supplied
+// ProviderConfig. The fallback Secret stays in use until this succeeds.
+func (r *healthReconciler) restore(ctx context.Context, pc *v1beta1.ProviderConfig) {
+	fb := pc.Status.RotatedCredentialsSecretRef
+	ref := pc.Spec.Credentials.SecretRef
+	if fb == nil {
+		return
+	}
+	if pc.Spec.Credentials.Source != xpv1.CredentialsSourceSecret || ref == nil {
+		// The credentials were replaced in the meantime.
+		r.deleteFallback(ctx, pc)
+		return
+	}
+
+	s := &corev1.Secret{}
+	err := r.kube.Get(ctx, types.NamespacedName{Namespace: fb.Namespace, Name: fb.Name}, s)
+	if kerrors.IsNotFound(err) {
+		pc.Status.RotatedCredentialsSecretRef = nil
+		return
+	}
+	if err != nil {
+		r.log.Info(fmt.Sprintf(errGetFallback, fb.Namespace, fb.Name), "error", err)
+		return
+	}
+	if err := r.writeToken(ctx, ref, string(s.Data[fb.Key])); err != nil {
+		r.log.Info(errWriteToken, "error", err)
+		return
+	}
+	r.deleteFallback(ctx, pc)
+	r.record.Event(pc, event.Normal(reasonRestoredToken, fmt.Sprintf("Wrote rotated access token from secret %s/%s into credentials secret", fb.Namespace, fb.Name)))
+}
+
+// writeToken writes the supplied token into the supplied credentials Secret.
+func (r *healthReconciler) writeToken(ctx context.Context, ref *xpv1.SecretKeySelector, token string) error {
+	return retry.OnError(r.writeBackoff, isTransient, func() error {
+		s := &corev1.Secret{}
+		if err := r.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+			return err
+		}
+		if s.Data == nil {
+			s.Data = map[string][]byte{}
+		}
+		s.Data[ref.Key] = []byte(token)
+		return r.kube.Update(ctx, s)
+	})
+}
+
+// deleteFallback deletes the fallback Secret of the supplied ProviderConfig,
+// if any, once its token is no longer needed.
+func (r *healthReconciler) deleteFallback(ctx context.Context, pc *v1beta1.ProviderConfig) {
+	fb := pc.Status.RotatedCredentialsSecretRef
+	if fb == nil {
+		return
+	}
+	s := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: fb.Namespace, Name: fb.Name}}
+	if err := r.kube.Delete(ctx, s); resource.IgnoreNotFound(err) != nil {
+		// The fallback Secret is no longer read, so only log the failure.
+		r.log.Info("Cannot delete secret holding the rotated access token", "secret", fb.Namespace+"/"+fb.Name, "error", err)
+	}
+	pc.Status.RotatedCredentialsSecretRef = nil
+}
+
+// writeFallback writes the supplied token into a new Secret in the namespace
+// of the supplied credentials Secret and returns the name of the new Secret.
+func (r *healthReconciler) writeFallback(ctx context.Context, ref *xpv1.SecretKeySelector, token string) (string, error) {
+	s := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    ref.Namespace,
+			GenerateName: ref.Name + "-rotated-",
+		},
+		Data: map[string][]byte{ref.Key: []byte(token)},
+	}
+	err := retry.OnError(r.writeBackoff, isTransient, func() error {
+		return r.kube.Create(ctx, s)
+	})
+	return s.GetName(), err
+}
+
+// isTransient returns false for errors of the API server that do not go away
+// by trying again.
+func isTransient(err error) bool {
+	return !kerrors.IsNotFound(err) && !kerrors.IsForbidden(err) && !kerrors.IsUnauthorized(err) &&
+		!kerrors.IsInvalid(err) && !kerrors.IsBadRequest(err)
+}
+
+func rotateBefore(rot *v1beta1.TokenRotation) time.Duration {
+	if rot.RotateBefore == nil {
+		return defaultRotateBefore
+	}
+	return rot.RotateBefore.Duration
+}
+
+func responseError(res *gitlab.Response, err error) xpv1.Condition {
+	if res != nil && (res.StatusCode == http.StatusUnauthorized || res.StatusCode == http.StatusForbidden) {
+		return unavailable(ReasonUnauthorized, err)
+	}
+	return unavailable(ReasonUnreachable, err)
+}
+
+func unavailable(r xpv1.ConditionReason, err error) xpv1.Condition {
+	c := xpv1.Unavailable()
+	c.Reason = r
+	c.Message = err.Error()
+	return c
+}
+
+func hasScope(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if strings.EqualFold(s, scope) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,544 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	gitlab "github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+var (
+	errBoom = errors.New("boom")
+
+	expiresSoon = gitlab.ISOTime(time.Now().Add(48 * time.Hour).Truncate(24 * time.Hour))
+	expiresLate = gitlab.ISOTime(time.Now().Add(90 * 24 * time.Hour).Truncate(24 * time.Hour))
+)
+
+type mockHealthClient struct {
+	user    *gitlab.User
+	userRes *gitlab.Response
+	userErr error
+
+	token    *gitlab.PersonalAccessToken
+	tokenRes *gitlab.Response
+	tokenErr error
+
+	version *gitlab.Version
+
+	rotated   *gitlab.PersonalAccessToken
+	rotateErr error
+}
+
+func (m *mockHealthClient) CurrentUser(_ ...gitlab.RequestOptionFunc) (*gitlab.User, *gitlab.Response, error) {
+	return m.user, m.userRes, m.userErr
+}
+
+func (m *mockHealthClient) GetSinglePersonalAccessToken(_ ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+	return m.token, m.tokenRes, m.tokenErr
+}
+
+func (m *mockHealthClient) GetVersion(_ ...gitlab.RequestOptionFunc) (*gitlab.Version, *gitlab.Response, error) {
+	if m.version == nil {
+		return nil, nil, errBoom
+	}
+	return m.version, nil, nil
+}
+
+func (m *mockHealthClient) RotateSelfAccessToken(_ *clients.RotateSelfAccessTokenOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+	return m.rotated, nil, m.rotateErr
+}
+
+func response(code int) *gitlab.Response {
+	return &gitlab.Response{Response: &http.Response{StatusCode: code}}
+}
+
+func TestCheck(t *testing.T) {
+	type want struct {
+		cond   xpv1.Condition
+		status v1beta1.ProviderConfigStatus
+	}
+
+	cases := map[string]struct {
+		cl       *mockHealthClient
+		rotation *v1beta1.TokenRotation
+		method   v1beta1.AuthMethod
+		want     want
+	}{
+		"Healthy": {
+			cl: &mockHealthClient{
+				user:    &gitlab.User{Username: "bot"},
+				token:   &gitlab.PersonalAccessToken{Scopes: []string{"api"}, ExpiresAt: &expiresLate},
+				version: &gitlab.Version{Version: "16.5.0-ee", Revision: "abc"},
+			},
+			method: v1beta1.AuthMethodPersonal,
+			want: want{
+				cond: xpv1.Available(),
+				status: v1beta1.ProviderConfigStatus{
+					Username:       "bot",
+					TokenScopes:    []string{"api"},
+					TokenExpiresAt: &metav1.Time{Time: time.Time(expiresLate)},
+					Version:        "16.5.0-ee",
+					Revision:       "abc",
+				},
+			},
+		},
+		"Unauthorized": {
+			cl: &mockHealthClient{
+				userRes: response(http.StatusUnauthorized),
+				userErr: errBoom,
+			},
+			method: v1beta1.AuthMethodPersonal,
+			want: want{
+				cond: unavailable(ReasonUnauthorized, errors.Wrap(errBoom, errGetCurrentUser)),
+			},
+		},
+		"Unreachable": {
+			cl: &mockHealthClient{
+				userErr: errBoom,
+			},
+			method: v1beta1.AuthMethodPersonal,
+			want: want{
+				cond: unavailable(ReasonUnreachable, errors.Wrap(errBoom, errGetCurrentUser)),
+			},
+		},
+		"InsufficientScope": {
+			cl: &mockHealthClient{
+				user:  &gitlab.User{Username: "bot"},
+				token: &gitlab.PersonalAccessToken{Scopes: []string{"read_api"}},
+			},
+			method: v1beta1.AuthMethodPersonal,
+			want: want{
+				cond: unavailable(ReasonInsufficientScope, errors.Errorf(errMissingScope, requiredScope)),
+				status: v1beta1.ProviderConfigStatus{
+					Username:    "bot",
+					TokenScopes: []string{"read_api"},
+				},
+			},
+		},
+		"TokenExpiringSoon": {
+			cl: &mockHealthClient{
+				user:  &gitlab.User{Username: "bot"},
+				token: &gitlab.PersonalAccessToken{Scopes: []string{"api"}, ExpiresAt: &expiresSoon},
+			},
+			method: v1beta1.AuthMethodPersonal,
+			want: want{
+				cond: func() xpv1.Condition {
+					c := xpv1.Available()
+					c.Reason = ReasonTokenExpiringSoon
+					c.Message = fmt.Sprintf(errTokenExpiresSoon, time.Time(expiresSoon).Format(time.DateOnly))
+					return c
+				}(),
+				status: v1beta1.ProviderConfigStatus{
+					Username:       "bot",
+					TokenScopes:    []string{"api"},
+					TokenExpiresAt: &metav1.Time{Time: time.Time(expiresSoon)},
+				},
+			},
+		},
+		"RotationNotDue": {
+			cl: &mockHealthClient{
+				user:  &gitlab.User{Username: "bot"},
+				token: &gitlab.PersonalAccessToken{Scopes: []string{"api"}, ExpiresAt: &expiresSoon},
+			},
+			rotation: &v1beta1.TokenRotation{RotateBefore: &metav1.Duration{Duration: time.Hour}},
+			method:   v1beta1.AuthMethodPersonal,
+			want: want{
+				cond: xpv1.Available(),
+				status: v1beta1.ProviderConfigStatus{
+					Username:       "bot",
+					TokenScopes:    []string{"api"},
+					TokenExpiresAt: &metav1.Time{Time: time.Time(expiresSoon)},
+				},
+			},
+		},
+		"TokenEndpointNotFound": {
+			cl: &mockHealthClient{
+				user:     &gitlab.User{Username: "bot"},
+				tokenRes: response(http.StatusNotFound),
+				tokenErr: errBoom,
+			},
+			method: v1beta1.AuthMethodPersonal,
+			want: want{
+				cond:   xpv1.Available(),
+				status: v1beta1.ProviderConfigStatus{Username: "bot"},
+			},
+		},
+		"OAuthSkipsToken": {
+			cl: &mockHealthClient{
+				user:     &gitlab.User{Username: "app"},
+				tokenErr: errBoom,
+			},
+			method: v1beta1.AuthMethodOAuth,
+			want: want{
+				cond:   xpv1.Available(),
+				status: v1beta1.ProviderConfigStatus{Username: "app"},
+			},
+		},
+		"JobTokenNotChecked": {
+			cl: &mockHealthClient{
+				userErr: errBoom,
+			},
+			method: v1beta1.AuthMethodJob,
+			want: want{
+				cond: xpv1.Available(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &healthReconciler{tokenExpiryWarning: tokenExpiryWarning}
+			pc := &v1beta1.ProviderConfig{Spec: v1beta1.ProviderConfigSpec{Rotation: tc.rotation}}
+			got := r.check(context.Background(), pc, tc.cl, tc.method)
+			if diff := cmp.Diff(tc.want.cond, got, test.EquateConditions(), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, pc.Status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRotate(t *testing.T) {
+	secretRef := &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "gitlab"},
+		Key:             "token",
+	}
+	secretCredentials := v1beta1.ProviderCredentials{
+		Source:                    xpv1.CredentialsSourceSecret,
+		CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: secretRef},
+	}
+
+	rotated := &gitlab.PersonalAccessToken{
+		Token:     "new",
+		Scopes:    []string{"api"},
+		ExpiresAt: &expiresLate,
+	}
+	rotatedStatus := v1beta1.ProviderConfigStatus{
+		TokenScopes:    []string{"api"},
+		TokenExpiresAt: &metav1.Time{Time: time.Time(expiresLate)},
+	}
+	fallbackStatus := rotatedStatus
+	fallbackStatus.RotatedCredentialsSecretRef = &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "gitlab-rotated-x"},
+		Key:             "token",
+	}
+	errUnavailable := kerrors.NewServiceUnavailable("boom")
+
+	type want struct {
+		cond     xpv1.Condition
+		token    string
+		fallback string
+		status   v1beta1.ProviderConfigStatus
+		events   []event.Reason
+	}
+
+	cases := map[string]struct {
+		kube   *test.MockClient
+		cl     *mockHealthClient
+		cd     v1beta1.ProviderCredentials
+		method v1beta1.AuthMethod
+		want   want
+	}{
+		"NotASecret": {
+			cd:     v1beta1.ProviderCredentials{Source: xpv1.CredentialsSourceFilesystem},
+			method: v1beta1.AuthMethodPersonal,
+			want: want{
+				cond: unavailable(ReasonRotationFailed, errors.New(errRotationSource)),
+			},
+		},
+		"NotAPersonalToken": {
+			cd:     secretCredentials,
+			method: v1beta1.AuthMethodOAuth,
+			want: want{
+				cond: unavailable(ReasonRotationFailed, errors.New(errRotationSource)),
+			},
+		},
+		"RotateFailed": {
+			cl:     &mockHealthClient{rotateErr: errBoom},
+			cd:     secretCredentials,
+			method: v1beta1.AuthMethodPersonal,
+			want: want{
+				cond: unavailable(ReasonRotationFailed, errors.Wrap(errBoom, errRotateToken)),
+			},
+		},
+		"WriteFailed": {
+			kube: &test.MockClient{
+				MockGet:    test.NewMockGetFn(errBoom),
+				MockCreate: test.NewMockCreateFn(errBoom),
+			},
+			cl:     &mockHealthClient{rotated: &gitlab.PersonalAccessToken{Token: "new"}},
+			cd:     secretCredentials,
+			method: v1beta1.AuthMethodPersonal,
+			want: want{
+				cond: unavailable(ReasonRotationFailed, errors.Wrap(errBoom, errWriteToken)),
+			},
+		},
+		"WriteRetried": {
+			kube: &test.MockClient{
+				MockGet: failTimes(2, errUnavailable),
+			},
+			cl:     &mockHealthClient{rotated: rotated},
+			cd:     secretCredentials,
+			method: v1beta1.AuthMethodPersonal,
+			want: want{
+				cond:   xpv1.Available(),
+				token:  "new",
+				status: rotatedStatus,
+				events: []event.Reason{reasonRotatedToken},
+			},
+		},
+		"WrittenToFallback": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errUnavailable),
+			},
+			cl:     &mockHealthClient{rotated: rotated},
+			cd:     secretCredentials,
+			method: v1beta1.AuthMethodPersonal,
+			want: want{
+				cond:     unavailable(ReasonRotationFailed, errors.Wrapf(errUnavailable, errWroteFallback, "crossplane-system", "gitlab-rotated-x")),
+				fallback: "new",
+				status:   fallbackStatus,
+			},
+		},
+		"NotFoundWrittenToFallback": {
+			kube: &test.MockClient{
+				MockGet: failTimes(1, kerrors.NewNotFound(corev1.Resource("secrets"), "gitlab")),
+			},
+			cl:     &mockHealthClient{rotated: rotated},
+			cd:     secretCredentials,
+			method: v1beta1.AuthMethodPersonal,
+			want: want{
+				cond:     unavailable(ReasonRotationFailed, errors.Wrapf(kerrors.NewNotFound(corev1.Resource("secrets"), "gitlab"), errWroteFallback, "crossplane-system", "gitlab-rotated-x")),
+				fallback: "new",
+				status:   fallbackStatus,
+			},
+		},
+		"Rotated": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+			},
+			cl:     &mockHealthClient{rotated: rotated},
+			cd:     secretCredentials,
+			method: v1beta1.AuthMethodPersonal,
+			want: want{
+				cond:   xpv1.Available(),
+				token:  "new",
+				status: rotatedStatus,
+				events: []event.Reason{reasonRotatedToken},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var token, fallback string
+			if tc.kube != nil {
+				tc.kube.MockUpdate = func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+					token = string(obj.(*corev1.Secret).Data["token"])
+					return nil
+				}
+				if tc.kube.MockCreate == nil {
+					tc.kube.MockCreate = func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+						obj.SetName(obj.GetGenerateName() + "x")
+						fallback = string(obj.(*corev1.Secret).Data["token"])
+						return nil
+					}
+				}
+			}
+			rec := &eventRecorder{}
+			r := &healthReconciler{
+				kube:         tc.kube,
+				log:          logging.NewNopLogger(),
+				record:       rec,
+				writeBackoff: wait.Backoff{Steps: 3},
+			}
+			pc := &v1beta1.ProviderConfig{Spec: v1beta1.ProviderConfigSpec{
+				Credentials: tc.cd,
+				Rotation:    &v1beta1.TokenRotation{},
+			}}
+			got := r.rotate(context.Background(), pc, tc.cl, tc.method)
+			if diff := cmp.Diff(tc.want.cond, got, test.EquateConditions(), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.token, token); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.fallback, fallback); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.events, rec.reasons); diff != "" {
+				t.Errorf("events: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, pc.Status, cmpopts.IgnoreFields(v1beta1.ProviderConfigStatus{}, "LastRotationTime")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// failTimes returns a MockGetFn that fails with the supplied error for the
+// supplied number of calls and succeeds afterwards.
+func failTimes(n int, err error) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, _ client.Object) error {
+		if n > 0 {
+			n--
+			return err
+		}
+		return nil
+	}
+}
+
+// eventRecorder records the reasons of the events it is handed.
+type eventRecorder struct {
+	reasons []event.Reason
+}
+
+func (r *eventRecorder) Event(_ runtime.Object, e event.Event) {
+	r.reasons = append(r.reasons, e.Reason)
+}
+
+func (r *eventRecorder) WithAnnotations(_ ...string) event.Recorder { return r }
+
+func TestRestore(t *testing.T) {
+	credentials := v1beta1.ProviderCredentials{
+		Source: xpv1.CredentialsSourceSecret,
+		CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "gitlab"},
+			Key:             "token",
+		}},
+	}
+	fallback := &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "gitlab-rotated-x"},
+		Key:             "token",
+	}
+	getFallback := func(err error) test.MockGetFn {
+		return func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			if key.Name == fallback.Name {
+				if err != nil {
+					return err
+				}
+				obj.(*corev1.Secret).Data = map[string][]byte{"token": []byte("rotated")}
+			}
+			return nil
+		}
+	}
+
+	type want struct {
+		fallback *xpv1.SecretKeySelector
+		token    string
+		deleted  bool
+		events   []event.Reason
+	}
+
+	cases := map[string]struct {
+		kube     *test.MockClient
+		cd       v1beta1.ProviderCredentials
+		fallback *xpv1.SecretKeySelector
+		want     want
+	}{
+		"NoFallback": {
+			kube: &test.MockClient{},
+			cd:   credentials,
+		},
+		"Restored": {
+			kube:     &test.MockClient{MockGet: getFallback(nil)},
+			cd:       credentials,
+			fallback: fallback,
+			want: want{
+				token:   "rotated",
+				deleted: true,
+				events:  []event.Reason{reasonRestoredToken},
+			},
+		},
+		"WriteFailed": {
+			kube: &test.MockClient{
+				MockGet:    getFallback(nil),
+				MockUpdate: test.NewMockUpdateFn(kerrors.NewForbidden(corev1.Resource("secrets"), "gitlab", errBoom)),
+			},
+			cd:       credentials,
+			fallback: fallback,
+			want: want{
+				fallback: fallback,
+			},
+		},
+		"FallbackGone": {
+			kube:     &test.MockClient{MockGet: getFallback(kerrors.NewNotFound(corev1.Resource("secrets"), fallback.Name))},
+			cd:       credentials,
+			fallback: fallback,
+		},
+		"CredentialsReplaced": {
+			kube:     &test.MockClient{},
+			cd:       v1beta1.ProviderCredentials{Source: xpv1.CredentialsSourceFilesystem},
+			fallback: fallback,
+			want: want{
+				deleted: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got want
+			if tc.kube.MockUpdate == nil {
+				tc.kube.MockUpdate = func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+					got.token = string(obj.(*corev1.Secret).Data["token"])
+					return nil
+				}
+			}
+			tc.kube.MockDelete = func(_ context.Context, obj client.Object, _ ...client.DeleteOption) error {
+				got.deleted = obj.GetName() == fallback.Name
+				return nil
+			}
+			rec := &eventRecorder{}
+			r := &healthReconciler{kube: tc.kube, log: logging.NewNopLogger(), record: rec, writeBackoff: wait.Backoff{Steps: 1}}
+			pc := &v1beta1.ProviderConfig{
+				Spec:   v1beta1.ProviderConfigSpec{Credentials: tc.cd},
+				Status: v1beta1.ProviderConfigStatus{RotatedCredentialsSecretRef: tc.fallback},
+			}
+			r.restore(context.Background(), pc)
+			got.fallback = pc.Status.RotatedCredentialsSecretRef
+			got.events = rec.reasons
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -41,6 +41,8 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
 )
 
 const (
@@ -61,12 +63,14 @@ func SetupDeployToken(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewDeployTokenClient}),
+		managed.WithExternalConnecter(tracing.NewConnecter(clients.NewErrorConnecter(metrics.NewDriftConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewDeployTokenClient}, v1alpha1.DeployTokenGroupKind), mgr.GetClient(), recorder), v1alpha1.DeployTokenGroupKind)),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
-		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithLogger(tracing.NewLogger(o.Logger.WithValues("controller", name), v1alpha1.DeployTokenGroupKind)),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...),
 	}
 
@@ -81,7 +85,7 @@ func SetupDeployToken(mgr ctrl.Manager, o controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.DeployToken{}).
-		Complete(r)
+		Complete(tracing.NewReconciler(r, v1alpha1.DeployTokenGroupKind, o.Logger.WithValues("controller", name)))
 }
 
 type connector struct {
@@ -126,9 +130,10 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errGroupIDMissing)
 	}
 
-	dt, res, err := e.client.GetGroupDeployToken(*cr.Spec.ForProvider.GroupID, id)
+	dt, res, err := e.client.GetGroupDeployToken(*cr.Spec.ForProvider.GroupID, id, gitlab.WithContext(ctx))
 	if err != nil {
-		if clients.IsResponseNotFound(res) {
+		err = clients.Classify(res, err)
+		if clients.IsNotFound(err) {
 			return managed.ExternalObservation{}, nil
 		}
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
@@ -201,7 +206,7 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 		gitlab.WithContext(ctx),
 	)
 
-	return errors.Wrap(deleteError, errDeleteFailed)
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, deleteError), errDeleteFailed)
 }
 
 // lateInitializeGroupDeployToken fills the empty fields in the deploy token spec with the
@@ -18,6 +18,7 @@ package groups
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"time"
 
@@ -42,11 +43,13 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
 )
 
 const (
 	errNotGroup          = "managed resource is not a Gitlab Group custom resource"
-	errIDNotInt          = "specified ID is not an integer"
+	errParentMismatch    = "cannot adopt Gitlab Group %s: parent group %d does not match parentId %d"
 	errGetFailed         = "cannot get Gitlab Group"
 	errCreateFailed      = "cannot create Gitlab Group"
 	errUpdateFailed      = "cannot update Gitlab Group"
@@ -56,6 +59,9 @@ const (
 	errMissingGroupID    = "missing group ID for group to share with"
 	errSWGMissingGroupID = "FOllowing SharedWithGroup is missing GroupID: %v"
 	errLateInitialize    = "Error during LateInitialization: "
+	errTransferFailed    = "cannot transfer Gitlab Group to parent group %d"
+
+	reasonTransferredGroup event.Reason = "TransferredGroup"
 )
 
 // SetupGroup adds a controller that reconciles Groups.
@@ -67,12 +73,19 @@ func SetupGroup(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewGroupClient}),
+		managed.WithExternalConnecter(tracing.NewConnecter(clients.NewErrorConnecter(metrics.NewDriftConnecter(&connector{
+			kube:              mgr.GetClient(),
+			newGitlabClientFn: groups.NewGroupClient,
+			getInstanceFn:     clients.GetInstance,
+			recorder:          recorder,
+		}, v1alpha1.GroupKubernetesGroupKind), mgr.GetClient(), recorder), v1alpha1.GroupKubernetesGroupKind)),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
-		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithLogger(tracing.NewLogger(o.Logger.WithValues("controller", name), v1alpha1.GroupKubernetesGroupKind)),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...),
 	}
 
@@ -87,12 +100,14 @@ func SetupGroup(mgr ctrl.Manager, o controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.Group{}).
-		Complete(r)
+		Complete(tracing.NewReconciler(r, v1alpha1.GroupKubernetesGroupKind, o.Logger.WithValues("controller", name)))
 }
 
 type connector struct {
 	kube              client.Client
 	newGitlabClientFn func(cfg clients.Config) groups.Client
+	getInstanceFn     func(cfg clients.Config) clients.Instance
+	recorder          event.Recorder
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -104,12 +119,19 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+	return &external{
+		kube:     c.kube,
+		client:   c.newGitlabClientFn(*cfg),
+		instance: c.getInstanceFn(*cfg),
+		recorder: c.recorder,
+	}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client groups.Client
+	kube     client.Client
+	client   groups.Client
+	instance clients.Instance
+	recorder event.Recorder
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -123,30 +145,50 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 
-	groupID, err := strconv.Atoi(externalName)
-	if err != nil {
-		return managed.ExternalObservation{}, errors.New(errIDNotInt)
+	// The external name is either the ID of the group or, to adopt an
+	// existing group, its full path like group/subgroup.
+	var gid interface{} = externalName
+	if groupID, err := strconv.Atoi(externalName); err == nil {
+		gid = groupID
 	}
 
-	grp, res, err := e.client.GetGroup(groupID, nil)
+	grp, res, err := e.client.GetGroup(gid, nil, gitlab.WithContext(ctx))
 	if err != nil {
-		if clients.IsResponseNotFound(res) {
+		err = clients.Classify(res, err)
+		if clients.IsNotFound(err) {
 			return managed.ExternalObservation{}, nil
 		}
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
 	}
 
+	// Groups adopted by path are pinned to their ID and parent group, so that
+	// they are still found after being renamed or transferred and are not
+	// moved to the top level. A group that lives below another parent than
+	// the desired one is not adopted, since the path may have been mistaken.
+	_, adopted := gid.(string)
+	if adopted {
+		if pid := cr.Spec.ForProvider.ParentID; pid != nil && *pid != grp.ParentID {
+			return managed.ExternalObservation{}, errors.Errorf(errParentMismatch, externalName, grp.ParentID, *pid)
+		}
+		meta.SetExternalName(cr, strconv.Itoa(grp.ID))
+		cr.Spec.ForProvider.ParentID = &grp.ParentID
+	}
+
 	current := cr.Spec.ForProvider.DeepCopy()
 
-	err = lateInitialize(&cr.Spec.ForProvider, grp)
+	err = lateInitialize(&cr.Spec.ForProvider, grp, e.instance)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
 	}
-	isResourceLateInitialized := !cmp.Equal(current, &cr.Spec.ForProvider)
+	isResourceLateInitialized := adopted || !cmp.Equal(current, &cr.Spec.ForProvider)
 
+	lastError := cr.Status.AtProvider.LastError
 	cr.Status.AtProvider = groups.GenerateObservation(grp)
+	cr.Status.AtProvider.LastError = lastError
 	cr.Status.SetConditions(xpv1.Available())
-	isUpToDate, err := isGroupUpToDate(&cr.Spec.ForProvider, grp)
+	p, unsupported := groups.SupportedParameters(&cr.Spec.ForProvider, e.instance)
+	clients.RecordUnsupportedFields(e.recorder, cr, e.instance, unsupported)
+	isUpToDate, err := isGroupUpToDate(p, grp)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
 	}
@@ -165,10 +207,13 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotGroup)
 	}
 
-	grp, _, err := e.client.CreateGroup(
-		groups.GenerateCreateGroupOptions(cr.Name, &cr.Spec.ForProvider),
+	p, _ := groups.SupportedParameters(&cr.Spec.ForProvider, e.instance)
+	grp, res, err := e.client.CreateGroup(
+		groups.GenerateCreateGroupOptions(cr.Name, p),
 		gitlab.WithContext(ctx),
 	)
+	err = clients.Classify(res, err)
+	cr.Status.AtProvider.LastError = clients.GenerateLastError(err)
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 	}
@@ -182,11 +227,17 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotGroup)
 	}
-	grp, _, err := e.client.UpdateGroup(
+	if err := e.transfer(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	p, _ := groups.SupportedParameters(&cr.Spec.ForProvider, e.instance)
+	grp, res, err := e.client.UpdateGroup(
 		meta.GetExternalName(cr),
-		groups.GenerateEditGroupOptions(cr.Name, &cr.Spec.ForProvider),
+		groups.GenerateEditGroupOptions(cr.Name, p),
 		gitlab.WithContext(ctx),
 	)
+	err = clients.Classify(res, err)
+	cr.Status.AtProvider.LastError = clients.GenerateLastError(err)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
 	}
@@ -204,8 +255,10 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 				if sh.ExpiresAt != nil {
 					opt.ExpiresAt = (*gitlab.ISOTime)(&sh.ExpiresAt.Time) //nolint:gosec
 				}
-				_, _, err = e.client.ShareGroupWithGroup(grp.ID, &opt)
+				_, res, err = e.client.ShareGroupWithGroup(grp.ID, &opt, gitlab.WithContext(ctx))
 				if err != nil {
+					err = clients.Classify(res, err)
+					cr.Status.AtProvider.LastError = clients.GenerateLastError(err)
 					return managed.ExternalUpdate{}, errors.Wrapf(err, errShareFailed, *sh.GroupID)
 				}
 			}
@@ -219,8 +272,10 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 				return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
 			}
 			if isNotUnshared {
-				_, err = e.client.UnshareGroupFromGroup(grp.ID, sh.GroupID)
+				res, err := e.client.UnshareGroupFromGroup(grp.ID, sh.GroupID, gitlab.WithContext(ctx))
 				if err != nil {
+					err = clients.Classify(res, err)
+					cr.Status.AtProvider.LastError = clients.GenerateLastError(err)
 					return managed.ExternalUpdate{}, errors.Wrapf(err, errUnshareFailed, sh.GroupID)
 				}
 			}
@@ -230,6 +285,45 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	return managed.ExternalUpdate{}, nil
 }
 
+// transfer moves the group below the desired parent group, or makes it a
+// top-level group if no parent or 0 is desired, if it was observed below
+// another one.
+func (e *external) transfer(ctx context.Context, cr *v1alpha1.Group) error {
+	parent, from := parentID(&cr.Spec.ForProvider), cr.Status.AtProvider.ParentID
+	if from == nil || *parent == *from {
+		return nil
+	}
+
+	opt := &gitlab.TransferSubGroupOptions{}
+	if *parent != 0 {
+		opt.GroupID = parent
+	}
+	grp, res, err := e.client.TransferSubGroup(meta.GetExternalName(cr), opt, gitlab.WithContext(ctx))
+	err = clients.Classify(res, err)
+	cr.Status.AtProvider.LastError = clients.GenerateLastError(err)
+	if err != nil {
+		return errors.Wrapf(err, errTransferFailed, *parent)
+	}
+
+	oldPath := ""
+	if cr.Status.AtProvider.FullPath != nil {
+		oldPath = *cr.Status.AtProvider.FullPath
+	}
+	cr.Status.AtProvider.ParentID = &grp.ParentID
+	cr.Status.AtProvider.FullPath = &grp.FullPath
+	e.recorder.Event(cr, event.Normal(reasonTransferredGroup, fmt.Sprintf("Transferred group from %s to %s", oldPath, grp.FullPath)))
+	return nil
+}
+
+// parentID returns the desired parent group ID. Groups without a parent
+// group are top-level groups, which Gitlab reports as parent 0.
+func parentID(p *v1alpha1.GroupParameters) *int {
+	if p.ParentID == nil {
+		return new(int)
+	}
+	return p.ParentID
+}
+
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	cr, ok := mg.(*v1alpha1.Group)
 	if !ok {
@@ -237,7 +331,7 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	}
 
 	_, err := e.client.DeleteGroup(meta.GetExternalName(cr), gitlab.WithContext(ctx))
-	return errors.Wrap(err, errDeleteFailed)
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, err), errDeleteFailed)
 }
 
 // isGroupUpToDate checks whether there is a change in any of the modifiable fields.
@@ -287,7 +381,7 @@ func isGroupUpToDate(p *v1alpha1.GroupParameters, g *gitlab.Group) (bool, error)
 	if !clients.IsBoolEqualToBoolPtr(p.RequestAccessEnabled, g.RequestAccessEnabled) {
 		return false, nil
 	}
-	if !clients.IsIntEqualToIntPtr(p.ParentID, g.ParentID) {
+	if *parentID(p) != g.ParentID {
 		return false, nil
 	}
 	if !clients.IsIntEqualToIntPtr(p.SharedRunnersMinutesLimit, g.SharedRunnersMinutesLimit) {
@@ -338,8 +432,9 @@ func isSharedWithGroupsUpToDate(cr *v1alpha1.GroupParameters, in *gitlab.Group)
 }
 
 // lateInitialize fills the empty fields in the group spec with the
-// values seen in gitlab.Group.
-func lateInitialize(in *v1alpha1.GroupParameters, group *gitlab.Group) error { // nolint:gocyclo
+// values seen in gitlab.Group. Fields the Gitlab instance cannot honor are
+// left empty.
+func lateInitialize(in *v1alpha1.GroupParameters, group *gitlab.Group, instance clients.Instance) error { // nolint:gocyclo
 	if group == nil {
 		return nil
 	}
@@ -384,14 +479,13 @@ func lateInitialize(in *v1alpha1.GroupParameters, group *gitlab.Group) error { /
 	if in.RequestAccessEnabled == nil {
 		in.RequestAccessEnabled = &group.RequestAccessEnabled
 	}
-	if in.ParentID == nil {
-		in.ParentID = &group.ParentID
-	}
-	if in.SharedRunnersMinutesLimit == nil {
-		in.SharedRunnersMinutesLimit = &group.SharedRunnersMinutesLimit
-	}
-	if in.ExtraSharedRunnersMinutesLimit == nil {
-		in.ExtraSharedRunnersMinutesLimit = &group.ExtraSharedRunnersMinutesLimit
+	if instance.SupportsPremiumFeatures() {
+		if in.SharedRunnersMinutesLimit == nil {
+			in.SharedRunnersMinutesLimit = &group.SharedRunnersMinutesLimit
+		}
+		if in.ExtraSharedRunnersMinutesLimit == nil {
+			in.ExtraSharedRunnersMinutesLimit = &group.ExtraSharedRunnersMinutesLimit
+		}
 	}
 	return nil
 }
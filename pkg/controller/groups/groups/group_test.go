@@ -19,37 +19,55 @@ package groups
 import (
 	"context"
 	"net/http"
+	"net/url"
 	"reflect"
 	"testing"
 	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 	"github.com/xanzy/go-gitlab"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	kubefake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	xpfake "github.com/crossplane/crossplane-runtime/pkg/resource/fake"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
 	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups/fake"
 )
 
 var (
-	unexpecedItem      resource.Managed
-	path               = "path/to/group"
-	name               = "example-group"
-	displayName        = "Example Group"
-	groupAccessLevel   = 40
-	groupID            = 1234
-	groupIDtwo         = 123456
-	extName            = "1234"
-	errBoom            = errors.New("boom")
+	unexpecedItem    resource.Managed
+	path             = "path/to/group"
+	name             = "example-group"
+	displayName      = "Example Group"
+	groupAccessLevel = 40
+	groupID          = 1234
+	groupIDtwo       = 123456
+	extName          = "1234"
+	errBoom          = errors.New("boom")
+	errValidation    = &gitlab.ErrorResponse{
+		Body: []byte(`{"message":{"path":["has already been taken"]}}`),
+		Response: &http.Response{
+			StatusCode: http.StatusBadRequest,
+			Request:    &http.Request{Method: http.MethodPost, URL: &url.URL{Path: "/api/v4/groups"}},
+		},
+	}
 	expiresAt          = time.Now()
 	expiresAtIso       = (gitlab.ISOTime)(expiresAt)
 	extNameAnnotation  = map[string]string{meta.AnnotationKeyExternalName: extName}
@@ -129,6 +147,7 @@ func withClientDefaultValues() groupModifier {
 			WebURL:    &s,
 			FullName:  &s,
 			FullPath:  &s,
+			ParentID:  &i,
 			LDAPCN:    &s,
 		}
 	}
@@ -150,6 +169,24 @@ func withSharedWithGroupsObservation(s []v1alpha1.SharedWithGroupsObservation) g
 	return func(g *v1alpha1.Group) { g.Status.AtProvider.SharedWithGroups = s }
 }
 
+func withParentID(id int) groupModifier {
+	return func(g *v1alpha1.Group) { g.Spec.ForProvider.ParentID = &id }
+}
+
+func withoutParentID() groupModifier {
+	return func(g *v1alpha1.Group) { g.Spec.ForProvider.ParentID = nil }
+}
+
+func withParentIDObservation(id int) groupModifier {
+	return func(g *v1alpha1.Group) { g.Status.AtProvider.ParentID = &id }
+}
+
+func withLastError(reason, message string) groupModifier {
+	return func(g *v1alpha1.Group) {
+		g.Status.AtProvider.LastError = &common.LastError{Reason: reason, Message: message}
+	}
+}
+
 func group(m ...groupModifier) *v1alpha1.Group {
 	cr := &v1alpha1.Group{}
 	for _, f := range m {
@@ -248,18 +285,84 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
-		"NotIDExternalName": {
+		"PathExternalName": {
 			args: args{
 				group: &fake.MockClient{
 					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
-						return &gitlab.Group{}, &gitlab.Response{}, nil
+						return &gitlab.Group{ID: 1234, Name: name}, &gitlab.Response{}, nil
+					},
+				},
+				cr: group(
+					withPath(""),
+					withClientDefaultValues(),
+					withExternalName("parent/example-group"),
+				),
+			},
+			want: want{
+				cr: group(
+					withPath(""),
+					withClientDefaultValues(),
+					withConditions(xpv1.Available()),
+					withExternalName(extName),
+					withStatus(groups.GenerateObservation(&gitlab.Group{ID: 1234, Name: name})),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
+			},
+		},
+		"PathExternalNameKeepsParent": {
+			args: args{
+				group: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{ID: 1234, Name: name, ParentID: 42}, &gitlab.Response{}, nil
+					},
+				},
+				cr: group(
+					withPath(""),
+					withClientDefaultValues(),
+					withoutParentID(),
+					withExternalName("parent/example-group"),
+				),
+			},
+			want: want{
+				cr: group(
+					withPath(""),
+					withClientDefaultValues(),
+					withParentID(42),
+					withConditions(xpv1.Available()),
+					withExternalName(extName),
+					withStatus(groups.GenerateObservation(&gitlab.Group{ID: 1234, Name: name, ParentID: 42})),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
+			},
+		},
+		"PathExternalNameParentMismatch": {
+			args: args{
+				group: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{ID: 1234, Name: name, ParentID: 42}, &gitlab.Response{}, nil
 					},
 				},
-				cr: group(withExternalName("fr")),
+				cr: group(
+					withClientDefaultValues(),
+					withExternalName("other/example-group"),
+				),
 			},
 			want: want{
-				cr:  group(withExternalName("fr")),
-				err: errors.New(errIDNotInt),
+				cr: group(
+					withClientDefaultValues(),
+					withExternalName("other/example-group"),
+				),
+				err: errors.Errorf(errParentMismatch, "other/example-group", 42, 0),
 			},
 		},
 		"FailedGetRequest": {
@@ -398,6 +501,7 @@ func TestObserve(t *testing.T) {
 				cr: group(
 					withExternalName("0"),
 					withClientDefaultValues(),
+					withoutParentID(),
 					withPath(path),
 					withConditions(xpv1.Available()),
 					withDescription(&description),
@@ -492,6 +596,10 @@ func TestObserve(t *testing.T) {
 			wantGroupModifier = append(wantGroupModifier, withPath(path))
 		}
 
+		if name == "ParentID" {
+			wantGroupModifier = append(wantGroupModifier, withParentIDObservation(value.(int)))
+		}
+
 		if name == "Description" {
 			argsGroupModifier = append(argsGroupModifier, withDescription(&description))
 			wantGroupModifier = append(wantGroupModifier, withDescription(&description))
@@ -597,10 +705,32 @@ func TestCreate(t *testing.T) {
 				cr: group(withStatus(v1alpha1.GroupObservation{ID: &groupID})),
 			},
 			want: want{
-				cr:  group(withStatus(v1alpha1.GroupObservation{ID: &groupID})),
+				cr:  group(withStatus(v1alpha1.GroupObservation{ID: &groupID}), withLastError("Other", "boom")),
 				err: errors.Wrap(errBoom, errCreateFailed),
 			},
 		},
+		"FailedValidation": {
+			args: args{
+				group: &fake.MockClient{
+					MockCreateGroup: func(opt *gitlab.CreateGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return nil, nil, errValidation
+					},
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(withStatus(v1alpha1.GroupObservation{
+					LastError: &common.LastError{
+						Reason:  "ValidationFailed",
+						Message: "Bad Request: path has already been taken",
+						FieldErrors: []common.FieldError{
+							{Field: "path", Messages: []string{"has already been taken"}},
+						},
+					},
+				})),
+				err: errors.Wrap(clients.Classify(nil, errValidation), errCreateFailed),
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -610,7 +740,7 @@ func TestCreate(t *testing.T) {
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
-			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(), cmpopts.IgnoreFields(common.LastError{}, "Time")); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
 			if diff := cmp.Diff(tc.want.result, o); diff != "" {
@@ -620,6 +750,55 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+// TestReconcileFailedCreation runs a managed reconciler against a fake API
+// server to ensure the last error of a failed Create is not lost when the
+// reconciler persists the critical annotations of the group.
+func TestReconcileFailedCreation(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := v1alpha1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	cr := group()
+	cr.SetName(name)
+	kube := kubefake.NewClientBuilder().WithScheme(s).WithObjects(cr).WithStatusSubresource(cr).Build()
+
+	c := managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return &external{kube: kube, client: &fake.MockClient{
+			MockCreateGroup: func(opt *gitlab.CreateGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+				return nil, nil, errValidation
+			},
+		}}, nil
+	})
+	r := managed.NewReconciler(&xpfake.Manager{Client: kube, Scheme: s},
+		resource.ManagedKind(v1alpha1.GroupKubernetesGroupVersionKind),
+		managed.WithExternalConnecter(clients.NewErrorConnecter(c, kube, event.NewNopRecorder())),
+		managed.WithInitializers(),
+	)
+
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: name}}); err != nil {
+		t.Fatal(err)
+	}
+
+	got := &v1alpha1.Group{}
+	if err := kube.Get(context.Background(), types.NamespacedName{Name: name}, got); err != nil {
+		t.Fatal(err)
+	}
+	want := &common.LastError{
+		Reason:      string(clients.ErrorKindValidation),
+		Message:     "Bad Request: path has already been taken",
+		FieldErrors: []common.FieldError{{Field: "path", Messages: []string{"has already been taken"}}},
+	}
+	if diff := cmp.Diff(want, got.Status.AtProvider.LastError, cmpopts.IgnoreFields(common.LastError{}, "Time")); diff != "" {
+		t.Errorf("LastError: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(xpv1.ConditionReason(clients.ErrorKindValidation), got.GetCondition(clients.TypeAPIError).Reason); diff != "" {
+		t.Errorf("APIError: -want, +got:\n%s", diff)
+	}
+	if meta.GetExternalCreateFailed(got).IsZero() {
+		t.Errorf("GetExternalCreateFailed: want the annotation to be set")
+	}
+}
+
 func TestUpdate(t *testing.T) {
 	type want struct {
 		cr     resource.Managed
@@ -777,6 +956,7 @@ func TestUpdate(t *testing.T) {
 							},
 						},
 					),
+					withLastError("Other", "boom"),
 				),
 				err:    errors.Wrapf(errBoom, errShareFailed, groupID),
 				result: managed.ExternalUpdate{},
@@ -806,11 +986,105 @@ func TestUpdate(t *testing.T) {
 				cr: group(),
 			},
 			want: want{
-				cr:     group(),
+				cr:     group(withLastError("Other", "boom")),
 				result: managed.ExternalUpdate{},
 				err:    errors.Wrapf(errBoom, errUnshareFailed, groupID),
 			},
 		},
+		"SuccessfulTransfer": {
+			args: args{
+				group: &fake.MockClient{
+					MockTransferSubGroup: func(gid interface{}, opt *gitlab.TransferSubGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						if opt.GroupID == nil || *opt.GroupID != 2 {
+							return nil, nil, errBoom
+						}
+						return &gitlab.Group{ParentID: 2, FullPath: "new/group"}, &gitlab.Response{}, nil
+					},
+					MockUpdateGroup: func(pid interface{}, opt *gitlab.UpdateGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: group(
+					withParentID(2),
+					withStatus(v1alpha1.GroupObservation{ParentID: ptr.To(1), FullPath: ptr.To("old/group")}),
+				),
+			},
+			want: want{
+				cr: group(
+					withParentID(2),
+					withStatus(v1alpha1.GroupObservation{ParentID: ptr.To(2), FullPath: ptr.To("new/group")}),
+				),
+			},
+		},
+		"SuccessfulTransferToTopLevel": {
+			args: args{
+				group: &fake.MockClient{
+					MockTransferSubGroup: func(gid interface{}, opt *gitlab.TransferSubGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						if opt.GroupID != nil {
+							return nil, nil, errBoom
+						}
+						return &gitlab.Group{FullPath: "group"}, &gitlab.Response{}, nil
+					},
+					MockUpdateGroup: func(pid interface{}, opt *gitlab.UpdateGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: group(
+					withParentID(0),
+					withStatus(v1alpha1.GroupObservation{ParentID: ptr.To(1), FullPath: ptr.To("old/group")}),
+				),
+			},
+			want: want{
+				cr: group(
+					withParentID(0),
+					withStatus(v1alpha1.GroupObservation{ParentID: ptr.To(0), FullPath: ptr.To("group")}),
+				),
+			},
+		},
+		"SuccessfulTransferToTopLevelWithoutParent": {
+			args: args{
+				group: &fake.MockClient{
+					MockTransferSubGroup: func(gid interface{}, opt *gitlab.TransferSubGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						if opt.GroupID != nil {
+							return nil, nil, errBoom
+						}
+						return &gitlab.Group{FullPath: "group"}, &gitlab.Response{}, nil
+					},
+					MockUpdateGroup: func(pid interface{}, opt *gitlab.UpdateGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: group(
+					withStatus(v1alpha1.GroupObservation{ParentID: ptr.To(1), FullPath: ptr.To("old/group")}),
+				),
+			},
+			want: want{
+				cr: group(
+					withStatus(v1alpha1.GroupObservation{ParentID: ptr.To(0), FullPath: ptr.To("group")}),
+				),
+			},
+		},
+		"FailedTransfer": {
+			args: args{
+				group: &fake.MockClient{
+					MockTransferSubGroup: func(gid interface{}, opt *gitlab.TransferSubGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: group(
+					withParentID(2),
+					withStatus(v1alpha1.GroupObservation{ParentID: ptr.To(1)}),
+				),
+			},
+			want: want{
+				cr: group(
+					withParentID(2),
+					withStatus(v1alpha1.GroupObservation{ParentID: ptr.To(1)}),
+					withLastError("Other", "boom"),
+				),
+				err: errors.Wrapf(errBoom, errTransferFailed, 2),
+			},
+		},
 		"FailedUpdate": {
 			args: args{
 				group: &fake.MockClient{
@@ -821,20 +1095,20 @@ func TestUpdate(t *testing.T) {
 				cr: group(withStatus(v1alpha1.GroupObservation{ID: &groupID})),
 			},
 			want: want{
-				cr:  group(withStatus(v1alpha1.GroupObservation{ID: &groupID})),
+				cr:  group(withStatus(v1alpha1.GroupObservation{ID: &groupID}), withLastError("Other", "boom")),
 				err: errors.Wrap(errBoom, errUpdateFailed),
 			},
 		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.group}
+			e := &external{kube: tc.kube, client: tc.group, recorder: event.NewNopRecorder()}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
-			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(), cmpopts.IgnoreFields(common.LastError{}, "Time")); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
 			if diff := cmp.Diff(tc.want.result, o); diff != "" {
@@ -877,6 +1151,20 @@ func TestDelete(t *testing.T) {
 				err: nil,
 			},
 		},
+		"AlreadyDeleted": {
+			args: args{
+				group: &fake.MockClient{
+					MockDeleteGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return nil, &gitlab.ErrorResponse{Response: &http.Response{StatusCode: http.StatusNotFound}}
+					},
+				},
+				cr: group(withExternalName("0")),
+			},
+			want: want{
+				cr:  group(withExternalName("0")),
+				err: nil,
+			},
+		},
 		"FailedDeletion": {
 			args: args{
 				group: &fake.MockClient{
@@ -36,6 +36,8 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/users"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
 )
 
 const (
@@ -59,15 +61,17 @@ func SetupMember(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{
+		managed.WithExternalConnecter(tracing.NewConnecter(clients.NewErrorConnecter(metrics.NewDriftConnecter(&connector{
 			kube:              mgr.GetClient(),
 			newGitlabClientFn: groups.NewMemberClient,
-			newUserClientFn:   users.NewUserClient}),
+			newUserClientFn:   users.NewUserClient}, v1alpha1.MemberKubernetesGroupKind), mgr.GetClient(), recorder), v1alpha1.MemberKubernetesGroupKind)),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
-		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithLogger(tracing.NewLogger(o.Logger.WithValues("controller", name), v1alpha1.MemberKubernetesGroupKind)),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...),
 	}
 
@@ -82,7 +86,7 @@ func SetupMember(mgr ctrl.Manager, o controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.Member{}).
-		Complete(r)
+		Complete(tracing.NewReconciler(r, v1alpha1.MemberKubernetesGroupKind, o.Logger.WithValues("controller", name)))
 }
 
 type connector struct {
@@ -134,9 +138,11 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	groupMember, res, err := e.client.GetGroupMember(
 		*cr.Spec.ForProvider.GroupID,
 		*cr.Spec.ForProvider.UserID,
+		gitlab.WithContext(ctx),
 	)
 	if err != nil {
-		if clients.IsResponseNotFound(res) {
+		err = clients.Classify(res, err)
+		if clients.IsNotFound(err) {
 			return managed.ExternalObservation{}, nil
 		}
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
@@ -217,7 +223,7 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 		nil,
 		gitlab.WithContext(ctx),
 	)
-	return errors.Wrap(err, errDeleteFailed)
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, err), errDeleteFailed)
 }
 
 // isMemberUpToDate checks whether there is a change in any of the modifiable fields.
@@ -39,6 +39,8 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
 )
 
 const (
@@ -61,12 +63,14 @@ func SetupVariable(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewVariableClient}),
+		managed.WithExternalConnecter(tracing.NewConnecter(clients.NewErrorConnecter(metrics.NewDriftConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewVariableClient}, v1alpha1.VariableGroupKind), mgr.GetClient(), recorder), v1alpha1.VariableGroupKind)),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
-		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithLogger(tracing.NewLogger(o.Logger.WithValues("controller", name), v1alpha1.VariableGroupKind)),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...),
 	}
 
@@ -81,7 +85,7 @@ func SetupVariable(mgr ctrl.Manager, o controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.Variable{}).
-		Complete(r)
+		Complete(tracing.NewReconciler(r, v1alpha1.VariableGroupKind, o.Logger.WithValues("controller", name)))
 }
 
 type connector struct {
@@ -121,7 +125,8 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		gitlab.WithContext(ctx))
 
 	if err != nil {
-		if clients.IsResponseNotFound(res) {
+		err = clients.Classify(res, err)
+		if clients.IsNotFound(err) {
 			return managed.ExternalObservation{}, nil
 		}
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
@@ -161,10 +166,12 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	cr.Status.SetConditions(xpv1.Creating())
-	_, _, err := e.client.CreateVariable(
+	_, res, err := e.client.CreateVariable(
 		*cr.Spec.ForProvider.GroupID,
 		groups.GenerateCreateVariableOptions(&cr.Spec.ForProvider),
 		gitlab.WithContext(ctx))
+	err = clients.Classify(res, err)
+	cr.Status.AtProvider.LastError = clients.GenerateLastError(err)
 
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
@@ -187,12 +194,14 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errGroupIDMissing)
 	}
 
-	_, _, err := e.client.UpdateVariable(
+	_, res, err := e.client.UpdateVariable(
 		*cr.Spec.ForProvider.GroupID,
 		cr.Spec.ForProvider.Key,
 		groups.GenerateUpdateVariableOptions(&cr.Spec.ForProvider),
 		gitlab.WithContext(ctx),
 	)
+	err = clients.Classify(res, err)
+	cr.Status.AtProvider.LastError = clients.GenerateLastError(err)
 	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
 }
 
@@ -212,7 +221,7 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 		cr.Spec.ForProvider.Key,
 		gitlab.WithContext(ctx),
 	)
-	return errors.Wrap(err, errDeleteFailed)
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, err), errDeleteFailed)
 }
 
 func (e *external) updateVariableFromSecret(ctx context.Context, selector *xpv1.SecretKeySelector, params *v1alpha1.VariableParameters) error {
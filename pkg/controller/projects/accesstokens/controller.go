@@ -42,6 +42,8 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
 )
 
 const (
@@ -64,12 +66,14 @@ func SetupAccessToken(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewAccessTokenClient}),
+		managed.WithExternalConnecter(tracing.NewConnecter(clients.NewErrorConnecter(metrics.NewDriftConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewAccessTokenClient}, v1alpha1.AccessTokenGroupKind), mgr.GetClient(), recorder), v1alpha1.AccessTokenGroupKind)),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
-		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithLogger(tracing.NewLogger(o.Logger.WithValues("controller", name), v1alpha1.AccessTokenGroupKind)),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...),
 	}
 
@@ -84,7 +88,7 @@ func SetupAccessToken(mgr ctrl.Manager, o controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.AccessToken{}).
-		Complete(r)
+		Complete(tracing.NewReconciler(r, v1alpha1.AccessTokenGroupKind, o.Logger.WithValues("controller", name)))
 }
 
 type connector struct {
@@ -129,9 +133,10 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errMissingProjectID)
 	}
 
-	at, res, err := e.client.GetProjectAccessToken(*cr.Spec.ForProvider.ProjectID, accessTokenID)
+	at, res, err := e.client.GetProjectAccessToken(*cr.Spec.ForProvider.ProjectID, accessTokenID, gitlab.WithContext(ctx))
 	if err != nil {
-		if clients.IsResponseNotFound(res) {
+		err = clients.Classify(res, err)
+		if clients.IsNotFound(err) {
 			return managed.ExternalObservation{}, nil
 		}
 		return managed.ExternalObservation{}, errors.Wrap(err, errAccessTokentNotFound)
@@ -203,7 +208,7 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 		gitlab.WithContext(ctx),
 	)
 
-	return errors.Wrap(err, errDeleteFailed)
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, err), errDeleteFailed)
 }
 
 // lateInitializeProjectAccessToken fills the empty fields in the access token spec with the
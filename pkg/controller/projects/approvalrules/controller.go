@@ -0,0 +1,217 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approvalrules
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
+)
+
+const (
+	errNotApprovalRule  = "managed resource is not a Gitlab approval rule custom resource"
+	errIDNotAnInt       = "external name is not an integer"
+	errGetFailed        = "cannot get Gitlab approval rule"
+	errCreateFailed     = "cannot create Gitlab approval rule"
+	errUpdateFailed     = "cannot update Gitlab approval rule"
+	errDeleteFailed     = "cannot delete Gitlab approval rule"
+	errProjectIDMissing = "ProjectID is missing"
+)
+
+// SetupApprovalRule adds a controller that reconciles ApprovalRule.
+func SetupApprovalRule(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ApprovalRuleKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(tracing.NewConnecter(clients.NewErrorConnecter(metrics.NewDriftConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewApprovalRuleClient}, v1alpha1.ApprovalRuleGroupKind), mgr.GetClient(), recorder), v1alpha1.ApprovalRuleGroupKind)),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(tracing.NewLogger(o.Logger.WithValues("controller", name), v1alpha1.ApprovalRuleGroupKind)),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ApprovalRuleGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ApprovalRule{}).
+		Complete(tracing.NewReconciler(r, v1alpha1.ApprovalRuleGroupKind, o.Logger.WithValues("controller", name)))
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.ApprovalRuleClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ApprovalRule)
+	if !ok {
+		return nil, errors.New(errNotApprovalRule)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.ApprovalRuleClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ApprovalRule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotApprovalRule)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.New(errIDNotAnInt)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	rule, res, err := e.client.GetProjectApprovalRule(*cr.Spec.ForProvider.ProjectID, id, gitlab.WithContext(ctx))
+	if err != nil {
+		err = clients.Classify(res, err)
+		if clients.IsNotFound(err) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	projects.LateInitializeApprovalRule(&cr.Spec.ForProvider, rule)
+
+	lastError := cr.Status.AtProvider.LastError
+	cr.Status.AtProvider = projects.GenerateApprovalRuleObservation(rule)
+	cr.Status.AtProvider.LastError = lastError
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        projects.IsApprovalRuleUpToDate(&cr.Spec.ForProvider, rule),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ApprovalRule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotApprovalRule)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	rule, res, err := e.client.CreateProjectApprovalRule(
+		*cr.Spec.ForProvider.ProjectID,
+		projects.GenerateCreateApprovalRuleOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx))
+	err = clients.Classify(res, err)
+	cr.Status.AtProvider.LastError = clients.GenerateLastError(err)
+
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	meta.SetExternalName(cr, strconv.Itoa(rule.ID))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ApprovalRule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotApprovalRule)
+	}
+	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.New(errIDNotAnInt)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+
+	_, res, err := e.client.UpdateProjectApprovalRule(
+		*cr.Spec.ForProvider.ProjectID,
+		id,
+		projects.GenerateUpdateApprovalRuleOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	err = clients.Classify(res, err)
+	cr.Status.AtProvider.LastError = clients.GenerateLastError(err)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ApprovalRule)
+	if !ok {
+		return errors.New(errNotApprovalRule)
+	}
+	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return errors.New(errIDNotAnInt)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	res, err := e.client.DeleteProjectApprovalRule(*cr.Spec.ForProvider.ProjectID, id, gitlab.WithContext(ctx))
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, clients.Classify(res, err)), errDeleteFailed)
+}
@@ -0,0 +1,498 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approvalrules
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/xanzy/go-gitlab"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom   = errors.New("boom")
+	projectID = "1234"
+	ruleID    = 7
+	ruleType  = "regular"
+	f         = false
+	notFound  = &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+)
+
+type args struct {
+	client projects.ApprovalRuleClient
+	cr     *v1alpha1.ApprovalRule
+}
+
+type approvalRuleModifier func(*v1alpha1.ApprovalRule)
+
+func withConditions(c ...xpv1.Condition) approvalRuleModifier {
+	return func(r *v1alpha1.ApprovalRule) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDefaultValues() approvalRuleModifier {
+	return func(r *v1alpha1.ApprovalRule) {
+		r.Spec.ForProvider = v1alpha1.ApprovalRuleParameters{
+			ProjectID:                     &projectID,
+			Name:                          "release",
+			ApprovalsRequired:             2,
+			RuleType:                      &ruleType,
+			UserIDs:                       []int{1},
+			AppliesToAllProtectedBranches: &f,
+		}
+	}
+}
+
+func withApprovalsRequired(n int) approvalRuleModifier {
+	return func(r *v1alpha1.ApprovalRule) {
+		r.Spec.ForProvider.ApprovalsRequired = n
+	}
+}
+
+func withExternalName(n string) approvalRuleModifier {
+	return func(r *v1alpha1.ApprovalRule) { meta.SetExternalName(r, n) }
+}
+
+func withObservation() approvalRuleModifier {
+	return func(r *v1alpha1.ApprovalRule) {
+		r.Status.AtProvider.ID = &ruleID
+		r.Status.AtProvider.EligibleApproverIDs = []int{1}
+	}
+}
+
+func withLastError(reason, message string) approvalRuleModifier {
+	return func(r *v1alpha1.ApprovalRule) {
+		r.Status.AtProvider.LastError = &common.LastError{Reason: reason, Message: message}
+	}
+}
+
+func approvalRule(m ...approvalRuleModifier) *v1alpha1.ApprovalRule {
+	cr := &v1alpha1.ApprovalRule{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed() *gitlab.ProjectApprovalRule {
+	return &gitlab.ProjectApprovalRule{
+		ID:                ruleID,
+		Name:              "release",
+		RuleType:          ruleType,
+		ApprovalsRequired: 2,
+		EligibleApprovers: []*gitlab.BasicUser{{ID: 1}},
+		Users:             []*gitlab.BasicUser{{ID: 1}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ApprovalRule
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProjectApprovalRule: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+				},
+				cr: approvalRule(withDefaultValues(), withExternalName("7")),
+			},
+			want: want{
+				cr: approvalRule(
+					withDefaultValues(),
+					withExternalName("7"),
+					withObservation(),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProjectApprovalRule: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+				},
+				cr: approvalRule(
+					withExternalName("7"),
+					func(r *v1alpha1.ApprovalRule) {
+						r.Spec.ForProvider = v1alpha1.ApprovalRuleParameters{
+							ProjectID:         &projectID,
+							Name:              "release",
+							ApprovalsRequired: 2,
+							UserIDs:           []int{1},
+						}
+					},
+				),
+			},
+			want: want{
+				cr: approvalRule(
+					withDefaultValues(),
+					withExternalName("7"),
+					withObservation(),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProjectApprovalRule: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+				},
+				cr: approvalRule(
+					withDefaultValues(),
+					withApprovalsRequired(3),
+					withExternalName("7"),
+					withLastError(string(clients.ErrorKindOther), "boom"),
+				),
+			},
+			want: want{
+				cr: approvalRule(
+					withDefaultValues(),
+					withApprovalsRequired(3),
+					withExternalName("7"),
+					withObservation(),
+					withLastError(string(clients.ErrorKindOther), "boom"),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: approvalRule(withDefaultValues()),
+			},
+			want: want{
+				cr: approvalRule(withDefaultValues()),
+			},
+		},
+		"ExternalNameNotAnInt": {
+			args: args{
+				cr: approvalRule(withDefaultValues(), withExternalName("release")),
+			},
+			want: want{
+				cr:  approvalRule(withDefaultValues(), withExternalName("release")),
+				err: errors.New(errIDNotAnInt),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProjectApprovalRule: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+						return nil, notFound, errBoom
+					},
+				},
+				cr: approvalRule(withDefaultValues(), withExternalName("7")),
+			},
+			want: want{
+				cr: approvalRule(withDefaultValues(), withExternalName("7")),
+			},
+		},
+		"GetError": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProjectApprovalRule: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: approvalRule(withDefaultValues(), withExternalName("7")),
+			},
+			want: want{
+				cr:  approvalRule(withDefaultValues(), withExternalName("7")),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: approvalRule(withExternalName("7")),
+			},
+			want: want{
+				cr:  approvalRule(withExternalName("7")),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ApprovalRule
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateProjectApprovalRule: func(pid interface{}, opt *gitlab.CreateProjectLevelRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+				},
+				cr: approvalRule(withDefaultValues()),
+			},
+			want: want{
+				cr: approvalRule(
+					withDefaultValues(),
+					withExternalName("7"),
+					withConditions(xpv1.Creating()),
+				),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateProjectApprovalRule: func(pid interface{}, opt *gitlab.CreateProjectLevelRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: approvalRule(withDefaultValues()),
+			},
+			want: want{
+				cr: approvalRule(
+					withDefaultValues(),
+					withConditions(xpv1.Creating()),
+					withLastError(string(clients.ErrorKindOther), "boom"),
+				),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(), cmpopts.IgnoreFields(common.LastError{}, "Time")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ApprovalRule
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulUpdate": {
+			args: args{
+				client: &fake.MockClient{
+					MockUpdateProjectApprovalRule: func(pid interface{}, id int, opt *gitlab.UpdateProjectLevelRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+				},
+				cr: approvalRule(
+					withDefaultValues(),
+					withExternalName("7"),
+					withLastError(string(clients.ErrorKindOther), "boom"),
+				),
+			},
+			want: want{
+				cr: approvalRule(withDefaultValues(), withExternalName("7")),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				client: &fake.MockClient{
+					MockUpdateProjectApprovalRule: func(pid interface{}, id int, opt *gitlab.UpdateProjectLevelRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: approvalRule(withDefaultValues(), withExternalName("7")),
+			},
+			want: want{
+				cr: approvalRule(
+					withDefaultValues(),
+					withExternalName("7"),
+					withLastError(string(clients.ErrorKindOther), "boom"),
+				),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+		"ExternalNameNotAnInt": {
+			args: args{
+				cr: approvalRule(withDefaultValues()),
+			},
+			want: want{
+				cr:  approvalRule(withDefaultValues()),
+				err: errors.New(errIDNotAnInt),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(), cmpopts.IgnoreFields(common.LastError{}, "Time")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ApprovalRule
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteProjectApprovalRule: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: approvalRule(withDefaultValues(), withExternalName("7")),
+			},
+			want: want{
+				cr: approvalRule(
+					withDefaultValues(),
+					withExternalName("7"),
+					withConditions(xpv1.Deleting()),
+				),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteProjectApprovalRule: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return notFound, errBoom
+					},
+				},
+				cr: approvalRule(withDefaultValues(), withExternalName("7")),
+			},
+			want: want{
+				cr: approvalRule(
+					withDefaultValues(),
+					withExternalName("7"),
+					withConditions(xpv1.Deleting()),
+				),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteProjectApprovalRule: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: approvalRule(withDefaultValues(), withExternalName("7")),
+			},
+			want: want{
+				cr: approvalRule(
+					withDefaultValues(),
+					withExternalName("7"),
+					withConditions(xpv1.Deleting()),
+				),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approvalsettings
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
+)
+
+const (
+	errNotApprovalSettings = "managed resource is not a Gitlab approval settings custom resource"
+	errGetFailed           = "cannot get Gitlab approval settings"
+	errUpdateFailed        = "cannot change Gitlab approval settings"
+	errProjectIDMissing    = "ProjectID is missing"
+)
+
+// SetupApprovalSettings adds a controller that reconciles ApprovalSettings.
+func SetupApprovalSettings(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ApprovalSettingsKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(tracing.NewConnecter(clients.NewErrorConnecter(metrics.NewDriftConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewApprovalSettingsClient}, v1alpha1.ApprovalSettingsGroupKind), mgr.GetClient(), recorder), v1alpha1.ApprovalSettingsGroupKind)),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(tracing.NewLogger(o.Logger.WithValues("controller", name), v1alpha1.ApprovalSettingsGroupKind)),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ApprovalSettingsGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ApprovalSettings{}).
+		Complete(tracing.NewReconciler(r, v1alpha1.ApprovalSettingsGroupKind, o.Logger.WithValues("controller", name)))
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.ApprovalSettingsClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ApprovalSettings)
+	if !ok {
+		return nil, errors.New(errNotApprovalSettings)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.ApprovalSettingsClient
+}
+
+// Observe reports the approval settings as existing once they are managed,
+// that is once they have been applied by Create, and until the resource is
+// deleted. Every project has approval settings, so they cannot be observed
+// to be gone.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ApprovalSettings)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotApprovalSettings)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+	if meta.GetExternalName(cr) == "" || meta.WasDeleted(cr) {
+		return managed.ExternalObservation{}, nil
+	}
+
+	settings, res, err := e.client.GetApprovalConfiguration(*cr.Spec.ForProvider.ProjectID, gitlab.WithContext(ctx))
+	if err != nil {
+		err = clients.Classify(res, err)
+		if clients.IsNotFound(err) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	projects.LateInitializeApprovalSettings(&cr.Spec.ForProvider, settings)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        projects.IsApprovalSettingsUpToDate(&cr.Spec.ForProvider, settings),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+// Create applies the approval settings to the project and records the
+// project as the external name.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ApprovalSettings)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotApprovalSettings)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	if err := e.change(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	meta.SetExternalName(cr, *cr.Spec.ForProvider.ProjectID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ApprovalSettings)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotApprovalSettings)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+
+	return managed.ExternalUpdate{}, e.change(ctx, cr)
+}
+
+// Delete leaves the approval settings of the project as they are.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ApprovalSettings)
+	if !ok {
+		return errors.New(errNotApprovalSettings)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	return nil
+}
+
+func (e *external) change(ctx context.Context, cr *v1alpha1.ApprovalSettings) error {
+	_, res, err := e.client.ChangeApprovalConfiguration(
+		*cr.Spec.ForProvider.ProjectID,
+		projects.GenerateChangeApprovalConfigurationOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	err = clients.Classify(res, err)
+	cr.Status.AtProvider.LastError = clients.GenerateLastError(err)
+	return errors.Wrap(err, errUpdateFailed)
+}
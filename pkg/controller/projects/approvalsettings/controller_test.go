@@ -0,0 +1,406 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approvalsettings
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom   = errors.New("boom")
+	projectID = "1234"
+	t         = true
+	f         = false
+	deleted   = metav1.Now()
+)
+
+type args struct {
+	client projects.ApprovalSettingsClient
+	cr     *v1alpha1.ApprovalSettings
+}
+
+type approvalSettingsModifier func(*v1alpha1.ApprovalSettings)
+
+func withConditions(c ...xpv1.Condition) approvalSettingsModifier {
+	return func(r *v1alpha1.ApprovalSettings) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDefaultValues() approvalSettingsModifier {
+	return func(r *v1alpha1.ApprovalSettings) {
+		r.Spec.ForProvider = v1alpha1.ApprovalSettingsParameters{
+			ProjectID:                                 &projectID,
+			ResetApprovalsOnPush:                      &t,
+			SelectiveCodeOwnerRemovals:                &f,
+			MergeRequestsAuthorApproval:               &f,
+			MergeRequestsDisableCommittersApproval:    &t,
+			RequirePasswordToApprove:                  &f,
+			DisableOverridingApproversPerMergeRequest: &f,
+		}
+	}
+}
+
+func withRequirePasswordToApprove(b bool) approvalSettingsModifier {
+	return func(r *v1alpha1.ApprovalSettings) {
+		r.Spec.ForProvider.RequirePasswordToApprove = &b
+	}
+}
+
+func withExternalName(n string) approvalSettingsModifier {
+	return func(r *v1alpha1.ApprovalSettings) { meta.SetExternalName(r, n) }
+}
+
+func withDeletionTimestamp() approvalSettingsModifier {
+	return func(r *v1alpha1.ApprovalSettings) {
+		r.SetDeletionTimestamp(&deleted)
+	}
+}
+
+func withLastError(reason, message string) approvalSettingsModifier {
+	return func(r *v1alpha1.ApprovalSettings) {
+		r.Status.AtProvider.LastError = &common.LastError{Reason: reason, Message: message}
+	}
+}
+
+func approvalSettings(m ...approvalSettingsModifier) *v1alpha1.ApprovalSettings {
+	cr := &v1alpha1.ApprovalSettings{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed() *gitlab.ProjectApprovals {
+	return &gitlab.ProjectApprovals{
+		ResetApprovalsOnPush:                   true,
+		MergeRequestsDisableCommittersApproval: true,
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ApprovalSettings
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetApprovalConfiguration: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+				},
+				cr: approvalSettings(withDefaultValues(), withExternalName(projectID)),
+			},
+			want: want{
+				cr: approvalSettings(
+					withDefaultValues(),
+					withExternalName(projectID),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetApprovalConfiguration: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+				},
+				cr: approvalSettings(
+					withExternalName(projectID),
+					func(r *v1alpha1.ApprovalSettings) { r.Spec.ForProvider.ProjectID = &projectID },
+				),
+			},
+			want: want{
+				cr: approvalSettings(
+					withDefaultValues(),
+					withExternalName(projectID),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetApprovalConfiguration: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+				},
+				cr: approvalSettings(
+					withDefaultValues(),
+					withRequirePasswordToApprove(true),
+					withExternalName(projectID),
+				),
+			},
+			want: want{
+				cr: approvalSettings(
+					withDefaultValues(),
+					withRequirePasswordToApprove(true),
+					withExternalName(projectID),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotManagedYet": {
+			args: args{
+				cr: approvalSettings(withDefaultValues()),
+			},
+			want: want{
+				cr: approvalSettings(withDefaultValues()),
+			},
+		},
+		"Deleted": {
+			args: args{
+				cr: approvalSettings(withDefaultValues(), withExternalName(projectID), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: approvalSettings(withDefaultValues(), withExternalName(projectID), withDeletionTimestamp()),
+			},
+		},
+		"ProjectNotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetApprovalConfiguration: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+				},
+				cr: approvalSettings(withDefaultValues(), withExternalName(projectID)),
+			},
+			want: want{
+				cr: approvalSettings(withDefaultValues(), withExternalName(projectID)),
+			},
+		},
+		"GetError": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetApprovalConfiguration: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: approvalSettings(withDefaultValues(), withExternalName(projectID)),
+			},
+			want: want{
+				cr:  approvalSettings(withDefaultValues(), withExternalName(projectID)),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: approvalSettings(),
+			},
+			want: want{
+				cr:  approvalSettings(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ApprovalSettings
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockChangeApprovalConfiguration: func(pid interface{}, opt *gitlab.ChangeApprovalConfigurationOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+				},
+				cr: approvalSettings(withDefaultValues()),
+			},
+			want: want{
+				cr: approvalSettings(
+					withDefaultValues(),
+					withExternalName(projectID),
+					withConditions(xpv1.Creating()),
+				),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockChangeApprovalConfiguration: func(pid interface{}, opt *gitlab.ChangeApprovalConfigurationOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: approvalSettings(withDefaultValues()),
+			},
+			want: want{
+				cr: approvalSettings(
+					withDefaultValues(),
+					withConditions(xpv1.Creating()),
+					withLastError(string(clients.ErrorKindOther), "boom"),
+				),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(), cmpopts.IgnoreFields(common.LastError{}, "Time")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ApprovalSettings
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulUpdate": {
+			args: args{
+				client: &fake.MockClient{
+					MockChangeApprovalConfiguration: func(pid interface{}, opt *gitlab.ChangeApprovalConfigurationOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+				},
+				cr: approvalSettings(
+					withDefaultValues(),
+					withExternalName(projectID),
+					withLastError(string(clients.ErrorKindOther), "boom"),
+				),
+			},
+			want: want{
+				cr: approvalSettings(withDefaultValues(), withExternalName(projectID)),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				client: &fake.MockClient{
+					MockChangeApprovalConfiguration: func(pid interface{}, opt *gitlab.ChangeApprovalConfigurationOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: approvalSettings(withDefaultValues(), withExternalName(projectID)),
+			},
+			want: want{
+				cr: approvalSettings(
+					withDefaultValues(),
+					withExternalName(projectID),
+					withLastError(string(clients.ErrorKindOther), "boom"),
+				),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(), cmpopts.IgnoreFields(common.LastError{}, "Time")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cr := approvalSettings(withDefaultValues(), withExternalName(projectID))
+	e := &external{client: &fake.MockClient{}}
+
+	if err := e.Delete(context.Background(), cr); err != nil {
+		t.Errorf("r: unexpected error: %v", err)
+	}
+	want := approvalSettings(withDefaultValues(), withExternalName(projectID), withConditions(xpv1.Deleting()))
+	if diff := cmp.Diff(want, cr, test.EquateConditions()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package branches
+
+import (
+	"context"
+
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
+)
+
+const (
+	errNotBranch        = "managed resource is not a Gitlab branch custom resource"
+	errGetFailed        = "cannot get Gitlab branch"
+	errCreateFailed     = "cannot create Gitlab branch"
+	errDeleteFailed     = "cannot delete Gitlab branch"
+	errProjectIDMissing = "ProjectID is missing"
+)
+
+// SetupBranch adds a controller that reconciles Branches.
+func SetupBranch(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.BranchKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(tracing.NewConnecter(clients.NewErrorConnecter(metrics.NewDriftConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewBranchClient}, v1alpha1.BranchGroupKind), mgr.GetClient(), recorder), v1alpha1.BranchGroupKind)),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(tracing.NewLogger(o.Logger.WithValues("controller", name), v1alpha1.BranchGroupKind)),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.BranchGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Branch{}).
+		Complete(tracing.NewReconciler(r, v1alpha1.BranchGroupKind, o.Logger.WithValues("controller", name)))
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.BranchClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Branch)
+	if !ok {
+		return nil, errors.New(errNotBranch)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.BranchClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Branch)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBranch)
+	}
+	// A branch that is kept in Gitlab is gone as far as the Branch is
+	// concerned once the Branch is deleted.
+	if meta.WasDeleted(cr) && !ptr.Deref(cr.Spec.ForProvider.DeleteOnRemoval, false) {
+		return managed.ExternalObservation{}, nil
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	b, res, err := e.client.GetBranch(*cr.Spec.ForProvider.ProjectID, cr.Spec.ForProvider.Name, gitlab.WithContext(ctx))
+	if err != nil {
+		err = clients.Classify(res, err)
+		if clients.IsNotFound(err) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	cr.Status.AtProvider = projects.GenerateBranchObservation(b)
+	cr.Status.SetConditions(xpv1.Available())
+
+	// The ref is only used to create the branch, so an existing branch is
+	// always up to date.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Branch)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBranch)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	_, res, err := e.client.CreateBranch(
+		*cr.Spec.ForProvider.ProjectID,
+		projects.GenerateCreateBranchOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx))
+	return managed.ExternalCreation{}, errors.Wrap(clients.Classify(res, err), errCreateFailed)
+}
+
+// Update is a no-op, as a branch has nothing that can be changed once it
+// exists.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete deletes the branch. It is only called if the deletion policy of the
+// Branch is Delete and deleteOnRemoval is set.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Branch)
+	if !ok {
+		return errors.New(errNotBranch)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	res, err := e.client.DeleteBranch(*cr.Spec.ForProvider.ProjectID, cr.Spec.ForProvider.Name, gitlab.WithContext(ctx))
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, clients.Classify(res, err)), errDeleteFailed)
+}
@@ -0,0 +1,376 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package branches
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom   = errors.New("boom")
+	projectID = "1234"
+	sha       = "7b5c3cc8be40ee161ae89a06bba6229da1032a0c"
+	notFound  = &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+	deletedAt = metav1.Now()
+)
+
+type args struct {
+	client projects.BranchClient
+	cr     *v1alpha1.Branch
+}
+
+type branchModifier func(*v1alpha1.Branch)
+
+func withConditions(c ...xpv1.Condition) branchModifier {
+	return func(r *v1alpha1.Branch) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDefaultValues() branchModifier {
+	return func(r *v1alpha1.Branch) {
+		r.Spec.ForProvider = v1alpha1.BranchParameters{
+			ProjectID: &projectID,
+			Name:      "develop",
+			Ref:       "main",
+		}
+	}
+}
+
+func withRef(ref string) branchModifier {
+	return func(r *v1alpha1.Branch) { r.Spec.ForProvider.Ref = ref }
+}
+
+func withDeleteOnRemoval() branchModifier {
+	return func(r *v1alpha1.Branch) { r.Spec.ForProvider.DeleteOnRemoval = ptr.To(true) }
+}
+
+func withDeletionTimestamp() branchModifier {
+	return func(r *v1alpha1.Branch) { r.SetDeletionTimestamp(&deletedAt) }
+}
+
+func withObservation() branchModifier {
+	return func(r *v1alpha1.Branch) {
+		r.Status.AtProvider = v1alpha1.BranchObservation{CommitSHA: sha, Protected: true}
+	}
+}
+
+func branch(m ...branchModifier) *v1alpha1.Branch {
+	cr := &v1alpha1.Branch{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed() *gitlab.Branch {
+	return &gitlab.Branch{
+		Name:      "develop",
+		Commit:    &gitlab.Commit{ID: sha},
+		Protected: true,
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Branch
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetBranch: func(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+				},
+				cr: branch(withDefaultValues()),
+			},
+			want: want{
+				cr: branch(
+					withDefaultValues(),
+					withObservation(),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"RefIgnoredOnceCreated": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetBranch: func(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+				},
+				cr: branch(withDefaultValues(), withRef("v2.0.0")),
+			},
+			want: want{
+				cr: branch(
+					withDefaultValues(),
+					withRef("v2.0.0"),
+					withObservation(),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetBranch: func(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+						return nil, notFound, errBoom
+					},
+				},
+				cr: branch(withDefaultValues()),
+			},
+			want: want{
+				cr: branch(withDefaultValues()),
+			},
+		},
+		"DeletedKeepsBranch": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetBranch: func(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+				cr: branch(withDefaultValues(), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: branch(withDefaultValues(), withDeletionTimestamp()),
+			},
+		},
+		"DeletedWithDeleteOnRemoval": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetBranch: func(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+				},
+				cr: branch(withDefaultValues(), withDeleteOnRemoval(), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: branch(
+					withDefaultValues(),
+					withDeleteOnRemoval(),
+					withDeletionTimestamp(),
+					withObservation(),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"GetError": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetBranch: func(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: branch(withDefaultValues()),
+			},
+			want: want{
+				cr:  branch(withDefaultValues()),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: branch(),
+			},
+			want: want{
+				cr:  branch(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Branch
+		opt *gitlab.CreateBranchOptions
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulCreation": {
+			args: args{
+				cr: branch(withDefaultValues()),
+			},
+			want: want{
+				cr:  branch(withDefaultValues(), withConditions(xpv1.Creating())),
+				opt: &gitlab.CreateBranchOptions{Branch: gitlab.String("develop"), Ref: gitlab.String("main")},
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateBranch: func(pid interface{}, opt *gitlab.CreateBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: branch(withDefaultValues()),
+			},
+			want: want{
+				cr:  branch(withDefaultValues(), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var opt *gitlab.CreateBranchOptions
+			if tc.client == nil {
+				tc.client = &fake.MockClient{
+					MockCreateBranch: func(pid interface{}, o *gitlab.CreateBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Branch, *gitlab.Response, error) {
+						opt = o
+						return observed(), &gitlab.Response{}, nil
+					},
+				}
+			}
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.opt, opt); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Branch
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteBranch: func(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: branch(withDefaultValues()),
+			},
+			want: want{
+				cr: branch(withDefaultValues(), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteBranch: func(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return notFound, errBoom
+					},
+				},
+				cr: branch(withDefaultValues()),
+			},
+			want: want{
+				cr: branch(withDefaultValues(), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteBranch: func(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: branch(withDefaultValues()),
+			},
+			want: want{
+				cr:  branch(withDefaultValues(), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
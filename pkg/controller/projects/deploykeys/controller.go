@@ -26,6 +26,8 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
 )
 
 const (
@@ -59,12 +61,14 @@ func SetupDeployKey(mgr ctrl.Manager, o crpc.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: newDeployKeyClient}),
+		managed.WithExternalConnecter(tracing.NewConnecter(clients.NewErrorConnecter(metrics.NewDriftConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: newDeployKeyClient}, v1alpha1.DeployKeyGroupKind), mgr.GetClient(), recorder), v1alpha1.DeployKeyGroupKind)),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
-		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithLogger(tracing.NewLogger(o.Logger.WithValues("controller", name), v1alpha1.DeployKeyGroupKind)),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...),
 	}
 
@@ -79,7 +83,7 @@ func SetupDeployKey(mgr ctrl.Manager, o crpc.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.DeployKey{}).
-		Complete(r)
+		Complete(tracing.NewReconciler(r, v1alpha1.DeployKeyGroupKind, o.Logger.WithValues("controller", name)))
 }
 
 func (c *connector) Connect(ctx context.Context, mgd resource.Managed) (managed.ExternalClient, error) {
@@ -118,10 +122,12 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	dk, res, err := e.client.GetDeployKey(
 		*cr.Spec.ForProvider.ProjectID,
 		id,
+		gitlab.WithContext(ctx),
 	)
 
 	if err != nil {
-		if clients.IsResponseNotFound(res) {
+		err = clients.Classify(res, err)
+		if clients.IsNotFound(err) {
 			return managed.ExternalObservation{}, nil
 		}
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetFail)
@@ -210,6 +216,7 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		cr.Spec.ForProvider.ProjectID,
 		id,
 		generateUpdateOptions(cr),
+		gitlab.WithContext(ctx),
 	)
 
 	return managed.ExternalUpdate{}, errors.Wrap(er, errUpdateFail)
@@ -236,9 +243,10 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	_, err = e.client.DeleteDeployKey(
 		*cr.Spec.ForProvider.ProjectID,
 		keyID,
+		gitlab.WithContext(ctx),
 	)
 
-	return errors.Wrap(err, errDeleteFail)
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, err), errDeleteFail)
 }
 
 func lateInitializeProjectDeployKey(local *v1alpha1.DeployKeyParameters, external *gitlab.ProjectDeployKey) {
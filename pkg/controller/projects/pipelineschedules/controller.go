@@ -39,6 +39,8 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
 )
 
 const (
@@ -64,12 +66,14 @@ func SetupPipelineSchedule(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: newPipelineScheduleClient}),
+		managed.WithExternalConnecter(tracing.NewConnecter(clients.NewErrorConnecter(metrics.NewDriftConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: newPipelineScheduleClient}, v1alpha1.PipelineScheduleGroupKind), mgr.GetClient(), recorder), v1alpha1.PipelineScheduleGroupKind)),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
-		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithLogger(tracing.NewLogger(o.Logger.WithValues("controller", name), v1alpha1.PipelineScheduleGroupKind)),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...),
 	}
 
@@ -84,7 +88,7 @@ func SetupPipelineSchedule(mgr ctrl.Manager, o controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.PipelineSchedule{}).
-		Complete(r)
+		Complete(tracing.NewReconciler(r, v1alpha1.PipelineScheduleGroupKind, o.Logger.WithValues("controller", name)))
 }
 
 type external struct {
@@ -136,10 +140,11 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNoProjectID)
 	}
 
-	ps, res, err := e.client.GetPipelineSchedule(*cr.Spec.ForProvider.ProjectID, id)
+	ps, res, err := e.client.GetPipelineSchedule(*cr.Spec.ForProvider.ProjectID, id, gitlab.WithContext(ctx))
 
 	if err != nil {
-		if clients.IsResponseNotFound(res) {
+		err = clients.Classify(res, err)
+		if clients.IsNotFound(err) {
 			return managed.ExternalObservation{}, nil
 		}
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetPipelineSchedule)
@@ -176,7 +181,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		Active:       cr.Spec.ForProvider.Active,
 	}
 
-	ps, _, err := e.client.CreatePipelineSchedule(*cr.Spec.ForProvider.ProjectID, opt)
+	ps, _, err := e.client.CreatePipelineSchedule(*cr.Spec.ForProvider.ProjectID, opt, gitlab.WithContext(ctx))
 
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreatePipelineSchedule)
@@ -194,6 +199,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 			*cr.Spec.ForProvider.ProjectID,
 			ps.ID,
 			opt,
+			gitlab.WithContext(ctx),
 		)
 		if err != nil {
 			return managed.ExternalCreation{}, errors.Wrapf(err, errCreatePipelineScheduleVariable, v)
@@ -233,6 +239,7 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		*cr.Spec.ForProvider.ProjectID,
 		id,
 		opt,
+		gitlab.WithContext(ctx),
 	)
 
 	if err != nil {
@@ -240,7 +247,7 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	if hasVariables(cr, ps) {
-		ps, _, err := e.client.GetPipelineSchedule(*cr.Spec.ForProvider.ProjectID, id)
+		ps, _, err := e.client.GetPipelineSchedule(*cr.Spec.ForProvider.ProjectID, id, gitlab.WithContext(ctx))
 		if err != nil {
 			return managed.ExternalUpdate{}, errors.Wrap(err, errGetPipelineSchedule)
 		}
@@ -255,6 +262,7 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 					*cr.Spec.ForProvider.ProjectID,
 					ps.ID,
 					opt,
+					gitlab.WithContext(ctx),
 				)
 				if err != nil {
 					return managed.ExternalUpdate{}, errors.Wrapf(err, errCreatePipelineScheduleVariable, v)
@@ -270,6 +278,7 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 					ps.ID,
 					v.Key,
 					opt,
+					gitlab.WithContext(ctx),
 				)
 				if err != nil {
 					return managed.ExternalUpdate{}, errors.Wrapf(err, errUpdatePipelineScheduleVariable, v)
@@ -282,6 +291,7 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 					*cr.Spec.ForProvider.ProjectID,
 					ps.ID,
 					v.Key,
+					gitlab.WithContext(ctx),
 				)
 				if err != nil {
 					return managed.ExternalUpdate{}, errors.Wrapf(err, errDeletePipelineScheduleVariable, v)
@@ -312,9 +322,10 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	_, err = e.client.DeletePipelineSchedule(
 		*cr.Spec.ForProvider.ProjectID,
 		id,
+		gitlab.WithContext(ctx),
 	)
 
-	return errors.Wrap(err, errDeletePipelineSchedule)
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, err), errDeletePipelineSchedule)
 }
 
 func newPipelineScheduleClient(c clients.Config) projects.PipelineScheduleClient {
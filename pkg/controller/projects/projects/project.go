@@ -18,6 +18,7 @@ package projects
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"github.com/xanzy/go-gitlab"
@@ -41,6 +42,8 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
 )
 
 const (
@@ -49,7 +52,21 @@ const (
 	errCreateFailed     = "cannot create Gitlab project"
 	errUpdateFailed     = "cannot update Gitlab project"
 	errDeleteFailed     = "cannot delete Gitlab project"
+	errArchiveFailed    = "cannot archive Gitlab project"
+	errUnarchiveFailed  = "cannot unarchive Gitlab project"
+	errShareFailed      = "cannot share Gitlab project with group %d"
+	errUnshareFailed    = "cannot unshare Gitlab project from group %d"
+	errMissingGroupID   = "missing group ID for group to share with"
+	errMarkedDeletion   = "project is marked for deletion"
 	errGetFailed        = "cannot retrieve Gitlab project with"
+	errAdoptNotFound    = "cannot find Gitlab project to adopt at path %q"
+	errTransferFailed   = "cannot transfer Gitlab project from namespace %s to %d"
+	errTransferConflict = "cannot transfer Gitlab project from namespace %s to %d, its path may already be taken there"
+
+	reasonTransferredProject event.Reason = "TransferredProject"
+
+	// dateFormat is the format of the expiration date of shares.
+	dateFormat = "2006-01-02"
 )
 
 // SetupProject adds a controller that reconciles Projects.
@@ -61,12 +78,19 @@ func SetupProject(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewProjectClient}),
+		managed.WithExternalConnecter(tracing.NewConnecter(clients.NewErrorConnecter(metrics.NewDriftConnecter(&connector{
+			kube:              mgr.GetClient(),
+			newGitlabClientFn: projects.NewProjectClient,
+			getInstanceFn:     clients.GetInstance,
+			recorder:          recorder,
+		}, v1alpha1.ProjectGroupKind), mgr.GetClient(), recorder), v1alpha1.ProjectGroupKind)),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
-		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithLogger(tracing.NewLogger(o.Logger.WithValues("controller", name), v1alpha1.ProjectGroupKind)),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...),
 	}
 
@@ -81,12 +105,14 @@ func SetupProject(mgr ctrl.Manager, o controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.Project{}).
-		Complete(r)
+		Complete(tracing.NewReconciler(r, v1alpha1.ProjectGroupKind, o.Logger.WithValues("controller", name)))
 }
 
 type connector struct {
 	kube              client.Client
 	newGitlabClientFn func(cfg clients.Config) projects.Client
+	getInstanceFn     func(cfg clients.Config) clients.Instance
+	recorder          event.Recorder
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -98,12 +124,19 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+	return &external{
+		kube:     c.kube,
+		client:   c.newGitlabClientFn(*cfg),
+		instance: c.getInstanceFn(*cfg),
+		recorder: c.recorder,
+	}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client projects.Client
+	kube     client.Client
+	client   projects.Client
+	instance clients.Instance
+	recorder event.Recorder
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -117,29 +150,69 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 
-	projectID, err := strconv.Atoi(externalName)
-	if err != nil {
-		return managed.ExternalObservation{}, errors.New(errNotProject)
+	// The external name is either the ID of the project or, to adopt an
+	// existing project, its full path like group/subgroup/project.
+	var pid interface{} = externalName
+	if projectID, err := strconv.Atoi(externalName); err == nil {
+		pid = projectID
 	}
 
-	prj, res, err := e.client.GetProject(projectID, nil)
+	prj, res, err := e.client.GetProject(pid, nil, gitlab.WithContext(ctx))
 	if err != nil {
-		if clients.IsResponseNotFound(res) {
+		err = clients.Classify(res, err)
+		_, path := pid.(string)
+		switch {
+		case clients.IsNotFound(err) && path && !meta.WasDeleted(cr):
+			// Creating a project would replace the path meant for adoption
+			// with the ID of a new project.
+			return managed.ExternalObservation{}, errors.Wrapf(err, errAdoptNotFound, externalName)
+		case clients.IsNotFound(err):
 			return managed.ExternalObservation{}, nil
 		}
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
 	}
 
+	// Projects adopted by path are pinned to their ID, so that they are still
+	// found after being renamed or transferred.
+	_, adopted := pid.(string)
+	if adopted {
+		meta.SetExternalName(cr, strconv.Itoa(prj.ID))
+	}
+
+	if meta.WasDeleted(cr) && isDeleted(cr, prj) {
+		return managed.ExternalObservation{}, nil
+	}
+
 	current := cr.Spec.ForProvider.DeepCopy()
-	lateInitialize(&cr.Spec.ForProvider, prj)
+	lateInitialize(&cr.Spec.ForProvider, prj, e.instance)
+	p, unsupported := projects.SupportedParameters(&cr.Spec.ForProvider, e.instance)
+	clients.RecordUnsupportedFields(e.recorder, cr, e.instance, unsupported)
 
+	lastError := cr.Status.AtProvider.LastError
 	cr.Status.AtProvider = projects.GenerateObservation(prj)
+	cr.Status.AtProvider.LastError = lastError
 	cr.Status.SetConditions(xpv1.Available())
 
+	upToDate := isProjectUpToDate(p, prj)
+	if upToDate && p.SharedWithGroups != nil {
+		shares, res, err := e.client.GetProjectSharedWithGroups(prj.ID, gitlab.WithContext(ctx))
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(clients.Classify(res, err), errGetFailed)
+		}
+		upToDate = isSharedWithGroupsUpToDate(p, shares)
+	}
+
+	// A project that is marked for deletion is still there, but it must not
+	// be updated anymore and is about to disappear.
+	if prj.MarkedForDeletionAt != nil {
+		cr.Status.SetConditions(xpv1.Unavailable().WithMessage(errMarkedDeletion))
+		upToDate = true
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        isProjectUpToDate(&cr.Spec.ForProvider, prj),
-		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: adopted || !cmp.Equal(current, &cr.Spec.ForProvider),
 		ConnectionDetails:       managed.ConnectionDetails{"runnersToken": []byte(prj.RunnersToken)},
 	}, nil
 }
@@ -150,10 +223,13 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotProject)
 	}
 
-	prj, _, err := e.client.CreateProject(
-		projects.GenerateCreateProjectOptions(cr.Name, &cr.Spec.ForProvider),
+	p, _ := projects.SupportedParameters(&cr.Spec.ForProvider, e.instance)
+	prj, res, err := e.client.CreateProject(
+		projects.GenerateCreateProjectOptions(cr.Name, p),
 		gitlab.WithContext(ctx),
 	)
+	err = clients.Classify(res, err)
+	cr.Status.AtProvider.LastError = clients.GenerateLastError(err)
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 	}
@@ -168,13 +244,172 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotProject)
 	}
 
-	_, _, err := e.client.EditProject(
+	if err := e.transfer(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// Archived projects are read-only, so an archived project is unarchived
+	// before being edited. It is archived again afterwards, even if the edit
+	// failed, unless it is desired to be unarchived.
+	wasArchived := cr.Status.AtProvider.Archived
+	archive := wasArchived
+	if cr.Spec.ForProvider.Archived != nil {
+		archive = *cr.Spec.ForProvider.Archived
+	}
+	if wasArchived {
+		if err := e.setArchived(ctx, cr, false); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	err := e.edit(ctx, cr)
+	if archive {
+		lastError := cr.Status.AtProvider.LastError
+		if aerr := e.setArchived(ctx, cr, true); err == nil {
+			err = aerr
+		} else {
+			cr.Status.AtProvider.LastError = lastError
+		}
+	}
+	return managed.ExternalUpdate{}, err
+}
+
+// edit edits the project and its shares with groups.
+func (e *external) edit(ctx context.Context, cr *v1alpha1.Project) error {
+	p, _ := projects.SupportedParameters(&cr.Spec.ForProvider, e.instance)
+	_, res, err := e.client.EditProject(
 		meta.GetExternalName(cr),
-		projects.GenerateEditProjectOptions(cr.Name, &cr.Spec.ForProvider),
+		projects.GenerateEditProjectOptions(cr.Name, p),
 		gitlab.WithContext(ctx),
 	)
+	err = clients.Classify(res, err)
+	cr.Status.AtProvider.LastError = clients.GenerateLastError(err)
+	if err != nil {
+		return errors.Wrap(err, errUpdateFailed)
+	}
+	return e.share(ctx, cr)
+}
+
+// share shares the project with the desired groups and unshares it from all
+// others. Gitlab cannot change the access level or expiration date of a
+// share, so shares with another access level or expiration date are
+// replaced.
+func (e *external) share(ctx context.Context, cr *v1alpha1.Project) error {
+	if cr.Spec.ForProvider.SharedWithGroups == nil {
+		return nil
+	}
 
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	shares, res, err := e.client.GetProjectSharedWithGroups(meta.GetExternalName(cr), gitlab.WithContext(ctx))
+	err = clients.Classify(res, err)
+	cr.Status.AtProvider.LastError = clients.GenerateLastError(err)
+	if err != nil {
+		return errors.Wrap(err, errGetFailed)
+	}
+
+	current := make(map[int]projects.SharedWithGroup, len(shares))
+	for _, sh := range shares {
+		current[sh.GroupID] = sh
+	}
+
+	desired := make(map[int]bool, len(cr.Spec.ForProvider.SharedWithGroups))
+	for _, sh := range cr.Spec.ForProvider.SharedWithGroups {
+		if sh.GroupID == nil {
+			return errors.New(errMissingGroupID)
+		}
+		desired[*sh.GroupID] = true
+
+		cur, shared := current[*sh.GroupID]
+		if shared && isShareUpToDate(sh, cur) {
+			continue
+		}
+		if shared {
+			if err := e.unshare(ctx, cr, *sh.GroupID); err != nil {
+				return err
+			}
+		}
+
+		opt := &gitlab.ShareWithGroupOptions{
+			GroupID:     sh.GroupID,
+			GroupAccess: gitlab.AccessLevel(gitlab.AccessLevelValue(sh.GroupAccessLevel)),
+		}
+		if sh.ExpiresAt != nil {
+			opt.ExpiresAt = gitlab.String(sh.ExpiresAt.Format(dateFormat))
+		}
+		res, err := e.client.ShareProjectWithGroup(meta.GetExternalName(cr), opt, gitlab.WithContext(ctx))
+		err = clients.Classify(res, err)
+		cr.Status.AtProvider.LastError = clients.GenerateLastError(err)
+		if err != nil {
+			return errors.Wrapf(err, errShareFailed, *sh.GroupID)
+		}
+	}
+
+	for _, sh := range shares {
+		if desired[sh.GroupID] {
+			continue
+		}
+		if err := e.unshare(ctx, cr, sh.GroupID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unshare unshares the project from a group.
+func (e *external) unshare(ctx context.Context, cr *v1alpha1.Project, groupID int) error {
+	res, err := e.client.DeleteSharedProjectFromGroup(meta.GetExternalName(cr), groupID, gitlab.WithContext(ctx))
+	err = resource.Ignore(clients.IsNotFound, clients.Classify(res, err))
+	cr.Status.AtProvider.LastError = clients.GenerateLastError(err)
+	return errors.Wrapf(err, errUnshareFailed, groupID)
+}
+
+// setArchived archives or unarchives the project.
+func (e *external) setArchived(ctx context.Context, cr *v1alpha1.Project, archived bool) error {
+	fn, msg := e.client.UnarchiveProject, errUnarchiveFailed
+	if archived {
+		fn, msg = e.client.ArchiveProject, errArchiveFailed
+	}
+	prj, res, err := fn(meta.GetExternalName(cr), gitlab.WithContext(ctx))
+	err = clients.Classify(res, err)
+	cr.Status.AtProvider.LastError = clients.GenerateLastError(err)
+	if err != nil {
+		return errors.Wrap(err, msg)
+	}
+	cr.Status.AtProvider.Archived = prj.Archived
+	return nil
+}
+
+// transfer moves the project into the desired namespace if it was observed
+// in another one.
+func (e *external) transfer(ctx context.Context, cr *v1alpha1.Project) error {
+	ns, from := cr.Spec.ForProvider.NamespaceID, cr.Status.AtProvider.Namespace
+	if ns == nil || from == nil || *ns == from.ID {
+		return nil
+	}
+
+	prj, res, err := e.client.TransferProject(
+		meta.GetExternalName(cr),
+		&gitlab.TransferProjectOptions{Namespace: *ns},
+		gitlab.WithContext(ctx),
+	)
+	err = clients.Classify(res, err)
+	cr.Status.AtProvider.LastError = clients.GenerateLastError(err)
+	switch {
+	case clients.IsValidation(err), clients.IsConflict(err):
+		// Gitlab refuses to transfer a project into a namespace that already
+		// has a project with the same path. Retrying won't help until either
+		// of them is renamed.
+		return errors.Wrapf(err, errTransferConflict, from.FullPath, *ns)
+	case err != nil:
+		return errors.Wrapf(err, errTransferFailed, from.FullPath, *ns)
+	}
+
+	to := strconv.Itoa(*ns)
+	if prj != nil && prj.Namespace != nil {
+		to = prj.Namespace.FullPath
+		cr.Status.AtProvider.Namespace = projects.GenerateObservation(prj).Namespace
+	}
+	e.recorder.Event(cr, event.Normal(reasonTransferredProject, fmt.Sprintf("Transferred project from namespace %s to %s", from.FullPath, to)))
+	return nil
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -183,20 +418,97 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 		return errors.New(errNotProject)
 	}
 
-	_, err := e.client.DeleteProject(meta.GetExternalName(cr), gitlab.WithContext(ctx))
-	return errors.Wrap(err, errDeleteFailed)
+	if deletionMode(cr) == v1alpha1.DeletionModeArchive {
+		_, res, err := e.client.ArchiveProject(meta.GetExternalName(cr), gitlab.WithContext(ctx))
+		return errors.Wrap(resource.Ignore(clients.IsNotFound, clients.Classify(res, err)), errArchiveFailed)
+	}
+
+	// Gitlab only removes projects permanently that are already marked for
+	// deletion, so these are deleted twice unless the instance deletes them
+	// right away.
+	opts := []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)}
+	if deletionMode(cr) == v1alpha1.DeletionModePermanent && cr.Status.AtProvider.MarkedForDeletionAt != nil {
+		opts = append(opts, projects.WithPermanentRemoval(cr.Status.AtProvider.PathWithNamespace))
+	}
+	res, err := e.client.DeleteProject(meta.GetExternalName(cr), opts...)
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, clients.Classify(res, err)), errDeleteFailed)
+}
+
+// isSharedWithGroupsUpToDate checks whether the project is shared with the
+// desired groups only, with the desired access levels and expiration dates.
+func isSharedWithGroupsUpToDate(p *v1alpha1.ProjectParameters, shares []projects.SharedWithGroup) bool {
+	if p.SharedWithGroups == nil {
+		return true
+	}
+	if len(p.SharedWithGroups) != len(shares) {
+		return false
+	}
+
+	current := make(map[int]projects.SharedWithGroup, len(shares))
+	for _, sh := range shares {
+		current[sh.GroupID] = sh
+	}
+	for _, sh := range p.SharedWithGroups {
+		if sh.GroupID == nil {
+			return false
+		}
+		if cur, ok := current[*sh.GroupID]; !ok || !isShareUpToDate(sh, cur) {
+			return false
+		}
+	}
+	return true
+}
+
+// isShareUpToDate checks whether a share has the desired access level and
+// expiration date. Shares expire at the end of a day, so only the dates are
+// compared.
+func isShareUpToDate(p v1alpha1.SharedWithGroupsParameters, sh projects.SharedWithGroup) bool {
+	if p.GroupAccessLevel != sh.GroupAccessLevel {
+		return false
+	}
+	want, got := "", ""
+	if p.ExpiresAt != nil {
+		want = p.ExpiresAt.Format(dateFormat)
+	}
+	if sh.ExpiresAt != nil {
+		got = sh.ExpiresAt.String()
+	}
+	return want == got
+}
+
+// deletionMode returns the deletion mode of the project, which defaults to
+// Delayed.
+func deletionMode(cr *v1alpha1.Project) v1alpha1.DeletionMode {
+	if cr.Spec.ForProvider.DeletionMode == nil {
+		return v1alpha1.DeletionModeDelayed
+	}
+	return *cr.Spec.ForProvider.DeletionMode
+}
+
+// isDeleted returns true if the project is gone as far as its deletion mode
+// is concerned, even though Gitlab still returns it.
+func isDeleted(cr *v1alpha1.Project, prj *gitlab.Project) bool {
+	switch deletionMode(cr) {
+	case v1alpha1.DeletionModeArchive:
+		return prj.Archived
+	case v1alpha1.DeletionModeDelayed:
+		return prj.MarkedForDeletionAt != nil
+	default:
+		return false
+	}
 }
 
 // lateInitialize fills the empty fields in the project spec with the
-// values seen in gitlab.Project.
-func lateInitialize(in *v1alpha1.ProjectParameters, project *gitlab.Project) { // nolint:gocyclo
+// values seen in gitlab.Project. Fields the Gitlab instance cannot honor are
+// left empty.
+func lateInitialize(in *v1alpha1.ProjectParameters, project *gitlab.Project, instance clients.Instance) { // nolint:gocyclo
 	if project == nil {
 		return
 	}
 	if in.AllowMergeOnSkippedPipeline == nil {
 		in.AllowMergeOnSkippedPipeline = &project.AllowMergeOnSkippedPipeline
 	}
-	if in.ApprovalsBeforeMerge == nil {
+	if in.ApprovalsBeforeMerge == nil && instance.SupportsPremiumFeatures() {
 		in.ApprovalsBeforeMerge = &project.ApprovalsBeforeMerge
 	}
 	if in.AutocloseReferencedIssues == nil {
@@ -297,12 +609,18 @@ func lateInitialize(in *v1alpha1.ProjectParameters, project *gitlab.Project) { /
 
 // isProjectUpToDate checks whether there is a change in any of the modifiable fields.
 func isProjectUpToDate(p *v1alpha1.ProjectParameters, g *gitlab.Project) bool { // nolint:gocyclo
+	if p.NamespaceID != nil && g.Namespace != nil && *p.NamespaceID != g.Namespace.ID {
+		return false
+	}
 	if p.Name != nil && !cmp.Equal(*p.Name, g.Name) {
 		return false
 	}
 	if !clients.IsBoolEqualToBoolPtr(p.AllowMergeOnSkippedPipeline, g.AllowMergeOnSkippedPipeline) {
 		return false
 	}
+	if !clients.IsBoolEqualToBoolPtr(p.Archived, g.Archived) {
+		return false
+	}
 	if !clients.IsIntEqualToIntPtr(p.ApprovalsBeforeMerge, g.ApprovalsBeforeMerge) {
 		return false
 	}
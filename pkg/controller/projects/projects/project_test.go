@@ -20,21 +20,33 @@ import (
 	"context"
 	"net/http"
 	"reflect"
+	"sort"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	kubefake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	xpfake "github.com/crossplane/crossplane-runtime/pkg/resource/fake"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
 )
@@ -45,13 +57,17 @@ var (
 	errBoom           = errors.New("boom")
 	projectID         = 1234
 	extName           = strconv.Itoa(projectID)
+	namespaceID       = 2
+	deletionTimestamp = metav1.Now()
+	markedAt          = gitlab.ISOTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
 	extNameAnnotation = map[string]string{meta.AnnotationKeyExternalName: extName}
 )
 
 type args struct {
-	project projects.Client
-	kube    client.Client
-	cr      resource.Managed
+	project  projects.Client
+	kube     client.Client
+	cr       resource.Managed
+	instance clients.Instance
 }
 
 type projectModifier func(*v1alpha1.Project)
@@ -115,6 +131,10 @@ func withClientDefaultValues() projectModifier {
 	}
 }
 
+func withApprovalsBeforeMerge(i int) projectModifier {
+	return func(p *v1alpha1.Project) { p.Spec.ForProvider.ApprovalsBeforeMerge = &i }
+}
+
 func withAnnotations(a map[string]string) projectModifier {
 	return func(p *v1alpha1.Project) { meta.AddAnnotations(p, a) }
 }
@@ -123,6 +143,59 @@ func withMirrorUserIDNil() projectModifier {
 	return func(p *v1alpha1.Project) { p.Spec.ForProvider.MirrorUserID = nil }
 }
 
+func withSharedWithGroups(levels map[int]int) projectModifier {
+	return func(r *v1alpha1.Project) {
+		ids := make([]int, 0, len(levels))
+		for id := range levels {
+			ids = append(ids, id)
+		}
+		sort.Ints(ids)
+		r.Spec.ForProvider.SharedWithGroups = []v1alpha1.SharedWithGroupsParameters{}
+		for _, id := range ids {
+			id := id
+			r.Spec.ForProvider.SharedWithGroups = append(r.Spec.ForProvider.SharedWithGroups, v1alpha1.SharedWithGroupsParameters{GroupID: &id, GroupAccessLevel: levels[id]})
+		}
+	}
+}
+
+// shares returns the shares of a project that is shared with the supplied
+// groups with the supplied access levels.
+func shares(levels map[int]int) []projects.SharedWithGroup {
+	sh := make([]projects.SharedWithGroup, 0, len(levels))
+	for id, level := range levels {
+		sh = append(sh, projects.SharedWithGroup{GroupID: id, GroupAccessLevel: level})
+	}
+	return sh
+}
+
+func withShareExpiresAt(groupID int, t time.Time) projectModifier {
+	return func(r *v1alpha1.Project) {
+		for i, sh := range r.Spec.ForProvider.SharedWithGroups {
+			if *sh.GroupID == groupID {
+				r.Spec.ForProvider.SharedWithGroups[i].ExpiresAt = &metav1.Time{Time: t}
+			}
+		}
+	}
+}
+
+func withArchived(a bool) projectModifier {
+	return func(r *v1alpha1.Project) { r.Spec.ForProvider.Archived = &a }
+}
+
+func withDeletionTimestamp() projectModifier {
+	return func(r *v1alpha1.Project) { r.SetDeletionTimestamp(&deletionTimestamp) }
+}
+
+func withDeletionMode(m v1alpha1.DeletionMode) projectModifier {
+	return func(r *v1alpha1.Project) { r.Spec.ForProvider.DeletionMode = &m }
+}
+
+func withLastError(reason, message string) projectModifier {
+	return func(r *v1alpha1.Project) {
+		r.Status.AtProvider.LastError = &common.LastError{Reason: reason, Message: message}
+	}
+}
+
 func project(m ...projectModifier) *v1alpha1.Project {
 	cr := &v1alpha1.Project{}
 	for _, f := range m {
@@ -211,18 +284,77 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
-		"NotIDExternalName": {
+		"PathExternalName": {
 			args: args{
 				project: &fake.MockClient{
 					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
-						return &gitlab.Project{}, &gitlab.Response{}, nil
+						if pid != "group/subgroup/project" {
+							return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+						}
+						return &gitlab.Project{ID: projectID}, &gitlab.Response{}, nil
 					},
 				},
-				cr: project(withExternalName("fr")),
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName("group/subgroup/project"),
+				),
 			},
 			want: want{
-				cr:  project(withExternalName("fr")),
-				err: errors.New(errNotProject),
+				cr: project(
+					withClientDefaultValues(),
+					withConditions(xpv1.Available()),
+					withExternalName(extName),
+					withStatus(v1alpha1.ProjectObservation{ID: projectID}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
+			},
+		},
+		"PathExternalNameNotFound": {
+			args: args{
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: project(withExternalName("group/subgroup/project")),
+			},
+			want: want{
+				cr:     project(withExternalName("group/subgroup/project")),
+				result: managed.ExternalObservation{ResourceExists: false},
+				err:    errors.Wrapf(errBoom, errAdoptNotFound, "group/subgroup/project"),
+			},
+		},
+		"IDExternalNameNotFound": {
+			args: args{
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: project(withExternalName(extName)),
+			},
+			want: want{
+				cr:     project(withExternalName(extName)),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"PathExternalNameNotFoundDeleted": {
+			args: args{
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: project(withExternalName("group/subgroup/project"), withDeletionTimestamp()),
+			},
+			want: want{
+				cr:     project(withExternalName("group/subgroup/project"), withDeletionTimestamp()),
+				result: managed.ExternalObservation{ResourceExists: false},
 			},
 		},
 		"FailedGetRequest": {
@@ -281,6 +413,94 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"ShareExpiresAtChanged": {
+			args: args{
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{Name: "example-project"}, &gitlab.Response{}, nil
+					},
+					MockGetProjectSharedWithGroups: func(pid interface{}, options ...gitlab.RequestOptionFunc) ([]projects.SharedWithGroup, *gitlab.Response, error) {
+						return shares(map[int]int{1: 30}), &gitlab.Response{}, nil
+					},
+				},
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withSharedWithGroups(map[int]int{1: 30}),
+					withShareExpiresAt(1, time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)),
+				),
+			},
+			want: want{
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withSharedWithGroups(map[int]int{1: 30}),
+					withShareExpiresAt(1, time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: false,
+					ConnectionDetails:       managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
+			},
+		},
+		"MarkedForDeletion": {
+			args: args{
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{Name: "example-project", MarkedForDeletionAt: &markedAt}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName(extName),
+				),
+			},
+			want: want{
+				cr: project(
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withConditions(xpv1.Unavailable().WithMessage(errMarkedDeletion)),
+					withStatus(v1alpha1.ProjectObservation{MarkedForDeletionAt: &metav1.Time{Time: time.Time(markedAt)}}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: false,
+					ConnectionDetails:       managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
+			},
+		},
+		"DeletedWhenMarkedForDeletion": {
+			args: args{
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{MarkedForDeletionAt: &markedAt}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withExternalName(extName), withDeletionTimestamp()),
+			},
+			want: want{
+				cr:     project(withExternalName(extName), withDeletionTimestamp()),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"DeletedWhenArchived": {
+			args: args{
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{Archived: true}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withExternalName(extName), withDeletionTimestamp(), withDeletionMode(v1alpha1.DeletionModeArchive)),
+			},
+			want: want{
+				cr:     project(withExternalName(extName), withDeletionTimestamp(), withDeletionMode(v1alpha1.DeletionModeArchive)),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
 		"LateInitSuccess": {
 			args: args{
 				kube: &test.MockClient{
@@ -312,6 +532,35 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"PremiumFieldIgnoredOnCE": {
+			args: args{
+				project: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{Name: "example-project"}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(
+					withClientDefaultValues(),
+					withApprovalsBeforeMerge(2),
+					withExternalName(extName),
+				),
+				instance: clients.Instance{Version: "16.5.0", Edition: clients.EditionCE},
+			},
+			want: want{
+				cr: project(
+					withClientDefaultValues(),
+					withApprovalsBeforeMerge(2),
+					withExternalName(extName),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: false,
+					ConnectionDetails:       managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
+			},
+		},
 		"LateInitSuccessMirrorUserIdZero": {
 			args: args{
 				kube: &test.MockClient{
@@ -383,6 +632,7 @@ func TestObserve(t *testing.T) {
 		"AutocloseReferencedIssues":                 true,
 		"AllowMergeOnSkippedPipeline":               true,
 		"CIForwardDeploymentEnabled":                true,
+		"Archived":                                  true,
 	}
 
 	f := false
@@ -431,6 +681,7 @@ func TestObserve(t *testing.T) {
 		AutocloseReferencedIssues:        &f,
 		AllowMergeOnSkippedPipeline:      &f,
 		CIForwardDeploymentEnabled:       &f,
+		Archived:                         &f,
 	}
 
 	for name, value := range isProjectUpToDateCases {
@@ -488,6 +739,9 @@ func TestObserve(t *testing.T) {
 		val := reflect.ValueOf(value)
 
 		structFieldValue.Set(val)
+		if name == "Archived" {
+			wantProjectModifier = append(wantProjectModifier, withStatus(v1alpha1.ProjectObservation{Archived: true}))
+		}
 		cases["IsProjectUpToDate"+name] = struct {
 			args
 			want
@@ -514,7 +768,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.project}
+			e := &external{kube: tc.kube, client: tc.project, instance: tc.instance, recorder: event.NewNopRecorder()}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -577,7 +831,7 @@ func TestCreate(t *testing.T) {
 				cr: project(),
 			},
 			want: want{
-				cr:  project(),
+				cr:  project(withLastError("Other", "boom")),
 				err: errors.Wrap(errBoom, errCreateFailed),
 			},
 		},
@@ -590,7 +844,7 @@ func TestCreate(t *testing.T) {
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
-			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(), cmpopts.IgnoreFields(common.LastError{}, "Time")); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
 			if diff := cmp.Diff(tc.want.result, o); diff != "" {
@@ -601,6 +855,59 @@ func TestCreate(t *testing.T) {
 
 }
 
+// TestReconcileFailedCreation runs a managed reconciler against a fake API
+// server to ensure the last error of a failed Create is not lost when the
+// reconciler persists the critical annotations of the project.
+func TestReconcileFailedCreation(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := v1alpha1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	cr := project()
+	cr.SetName(path)
+	kube := kubefake.NewClientBuilder().WithScheme(s).WithObjects(cr).WithStatusSubresource(cr).Build()
+
+	errTaken := &gitlab.ErrorResponse{
+		Body:     []byte(`{"message": {"path": ["has already been taken"]}}`),
+		Response: &http.Response{StatusCode: http.StatusBadRequest, Request: &http.Request{Method: http.MethodPost}},
+	}
+	c := managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return &external{kube: kube, client: &fake.MockClient{
+			MockCreateProject: func(opt *gitlab.CreateProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+				return nil, nil, errTaken
+			},
+		}}, nil
+	})
+	r := managed.NewReconciler(&xpfake.Manager{Client: kube, Scheme: s},
+		resource.ManagedKind(v1alpha1.ProjectGroupVersionKind),
+		managed.WithExternalConnecter(clients.NewErrorConnecter(c, kube, event.NewNopRecorder())),
+		managed.WithInitializers(),
+	)
+
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: path}}); err != nil {
+		t.Fatal(err)
+	}
+
+	got := &v1alpha1.Project{}
+	if err := kube.Get(context.Background(), types.NamespacedName{Name: path}, got); err != nil {
+		t.Fatal(err)
+	}
+	want := &common.LastError{
+		Reason:      string(clients.ErrorKindValidation),
+		Message:     "Bad Request: path has already been taken",
+		FieldErrors: []common.FieldError{{Field: "path", Messages: []string{"has already been taken"}}},
+	}
+	if diff := cmp.Diff(want, got.Status.AtProvider.LastError, cmpopts.IgnoreFields(common.LastError{}, "Time")); diff != "" {
+		t.Errorf("LastError: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(xpv1.ConditionReason(clients.ErrorKindValidation), got.GetCondition(clients.TypeAPIError).Reason); diff != "" {
+		t.Errorf("APIError: -want, +got:\n%s", diff)
+	}
+	if meta.GetExternalCreateFailed(got).IsZero() {
+		t.Errorf("GetExternalCreateFailed: want the annotation to be set")
+	}
+}
+
 func TestUpdate(t *testing.T) {
 	type want struct {
 		cr     resource.Managed
@@ -634,6 +941,248 @@ func TestUpdate(t *testing.T) {
 				cr: project(withStatus(v1alpha1.ProjectObservation{ID: 1234})),
 			},
 		},
+		"SuccessfulTransfer": {
+			args: args{
+				project: &fake.MockClient{
+					MockTransferProject: func(pid interface{}, opt *gitlab.TransferProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{Namespace: &gitlab.ProjectNamespace{ID: 2, FullPath: "new"}}, &gitlab.Response{}, nil
+					},
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(
+					withSpec(v1alpha1.ProjectParameters{NamespaceID: &namespaceID}),
+					withStatus(v1alpha1.ProjectObservation{ID: 1234, Namespace: &v1alpha1.ProjectNamespace{ID: 1, FullPath: "old"}}),
+				),
+			},
+			want: want{
+				cr: project(
+					withSpec(v1alpha1.ProjectParameters{NamespaceID: &namespaceID}),
+					withStatus(v1alpha1.ProjectObservation{ID: 1234, Namespace: &v1alpha1.ProjectNamespace{ID: 2, FullPath: "new"}}),
+				),
+			},
+		},
+		"TransferPathTaken": {
+			args: args{
+				project: &fake.MockClient{
+					MockTransferProject: func(pid interface{}, opt *gitlab.TransferProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusBadRequest}}, errBoom
+					},
+				},
+				cr: project(
+					withSpec(v1alpha1.ProjectParameters{NamespaceID: &namespaceID}),
+					withStatus(v1alpha1.ProjectObservation{ID: 1234, Namespace: &v1alpha1.ProjectNamespace{ID: 1, FullPath: "old"}}),
+				),
+			},
+			want: want{
+				cr: project(
+					withSpec(v1alpha1.ProjectParameters{NamespaceID: &namespaceID}),
+					withStatus(v1alpha1.ProjectObservation{ID: 1234, Namespace: &v1alpha1.ProjectNamespace{ID: 1, FullPath: "old"}}),
+					withLastError("ValidationFailed", "boom"),
+				),
+				err: errors.Wrapf(errBoom, errTransferConflict, "old", namespaceID),
+			},
+		},
+		"SuccessfulArchive": {
+			args: args{
+				project: &fake.MockClient{
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+					MockArchiveProject: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{Archived: true}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withArchived(true), withStatus(v1alpha1.ProjectObservation{ID: 1234})),
+			},
+			want: want{
+				cr: project(withArchived(true), withStatus(v1alpha1.ProjectObservation{ID: 1234, Archived: true})),
+			},
+		},
+		"SuccessfulUnarchive": {
+			args: args{
+				project: &fake.MockClient{
+					MockUnarchiveProject: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withArchived(false), withStatus(v1alpha1.ProjectObservation{ID: 1234, Archived: true})),
+			},
+			want: want{
+				cr: project(withArchived(false), withStatus(v1alpha1.ProjectObservation{ID: 1234})),
+			},
+		},
+		"EditArchived": {
+			args: args{
+				project: &fake.MockClient{
+					MockUnarchiveProject: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+					MockArchiveProject: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{Archived: true}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withStatus(v1alpha1.ProjectObservation{ID: 1234, Archived: true})),
+			},
+			want: want{
+				cr: project(withStatus(v1alpha1.ProjectObservation{ID: 1234, Archived: true})),
+			},
+		},
+		"FailedEditArchived": {
+			args: args{
+				project: &fake.MockClient{
+					MockUnarchiveProject: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+					MockArchiveProject: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{Archived: true}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withArchived(true), withStatus(v1alpha1.ProjectObservation{ID: 1234, Archived: true})),
+			},
+			want: want{
+				cr:  project(withArchived(true), withStatus(v1alpha1.ProjectObservation{ID: 1234, Archived: true}), withLastError("Other", "boom")),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+		"FailedArchive": {
+			args: args{
+				project: &fake.MockClient{
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+					MockArchiveProject: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: project(withArchived(true), withStatus(v1alpha1.ProjectObservation{ID: 1234})),
+			},
+			want: want{
+				cr:  project(withArchived(true), withStatus(v1alpha1.ProjectObservation{ID: 1234}), withLastError("Other", "boom")),
+				err: errors.Wrap(errBoom, errArchiveFailed),
+			},
+		},
+		"SuccessfulShare": {
+			args: args{
+				project: &fake.MockClient{
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+					MockGetProjectSharedWithGroups: func(pid interface{}, options ...gitlab.RequestOptionFunc) ([]projects.SharedWithGroup, *gitlab.Response, error) {
+						return shares(map[int]int{1: 30, 2: 30, 3: 30}), &gitlab.Response{}, nil
+					},
+					MockShareProjectWithGroup: func(pid interface{}, opt *gitlab.ShareWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						if *opt.GroupID != 2 && *opt.GroupID != 4 {
+							return nil, errors.Errorf("unexpected share with group %d", *opt.GroupID)
+						}
+						return &gitlab.Response{}, nil
+					},
+					MockDeleteSharedProjectFromGroup: func(pid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						if groupID != 2 && groupID != 3 {
+							return nil, errors.Errorf("unexpected unshare from group %d", groupID)
+						}
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withSharedWithGroups(map[int]int{1: 30, 2: 40, 4: 30})),
+			},
+			want: want{
+				cr: project(withSharedWithGroups(map[int]int{1: 30, 2: 40, 4: 30})),
+			},
+		},
+		"ShareExpiresAtChanged": {
+			args: args{
+				project: &fake.MockClient{
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+					MockGetProjectSharedWithGroups: func(pid interface{}, options ...gitlab.RequestOptionFunc) ([]projects.SharedWithGroup, *gitlab.Response, error) {
+						expiresAt := gitlab.ISOTime(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+						return []projects.SharedWithGroup{{GroupID: 1, GroupAccessLevel: 30, ExpiresAt: &expiresAt}}, &gitlab.Response{}, nil
+					},
+					MockDeleteSharedProjectFromGroup: func(pid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+					MockShareProjectWithGroup: func(pid interface{}, opt *gitlab.ShareWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						if opt.ExpiresAt == nil || *opt.ExpiresAt != "2031-06-30" {
+							return nil, errors.Errorf("unexpected expiration date %v", opt.ExpiresAt)
+						}
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withSharedWithGroups(map[int]int{1: 30}), withShareExpiresAt(1, time.Date(2031, 6, 30, 0, 0, 0, 0, time.UTC))),
+			},
+			want: want{
+				cr: project(withSharedWithGroups(map[int]int{1: 30}), withShareExpiresAt(1, time.Date(2031, 6, 30, 0, 0, 0, 0, time.UTC))),
+			},
+		},
+		"FailedGetShares": {
+			args: args{
+				project: &fake.MockClient{
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+					MockGetProjectSharedWithGroups: func(pid interface{}, options ...gitlab.RequestOptionFunc) ([]projects.SharedWithGroup, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: project(withSharedWithGroups(map[int]int{1: 30})),
+			},
+			want: want{
+				cr:  project(withSharedWithGroups(map[int]int{1: 30}), withLastError("Other", "boom")),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"FailedShare": {
+			args: args{
+				project: &fake.MockClient{
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+					MockGetProjectSharedWithGroups: func(pid interface{}, options ...gitlab.RequestOptionFunc) ([]projects.SharedWithGroup, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, nil
+					},
+					MockShareProjectWithGroup: func(pid interface{}, opt *gitlab.ShareWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: project(withSharedWithGroups(map[int]int{1: 30})),
+			},
+			want: want{
+				cr:  project(withSharedWithGroups(map[int]int{1: 30}), withLastError("Other", "boom")),
+				err: errors.Wrapf(errBoom, errShareFailed, 1),
+			},
+		},
+		"FailedUnshare": {
+			args: args{
+				project: &fake.MockClient{
+					MockEditProject: func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{}, &gitlab.Response{}, nil
+					},
+					MockGetProjectSharedWithGroups: func(pid interface{}, options ...gitlab.RequestOptionFunc) ([]projects.SharedWithGroup, *gitlab.Response, error) {
+						return shares(map[int]int{1: 30}), &gitlab.Response{}, nil
+					},
+					MockDeleteSharedProjectFromGroup: func(pid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: project(withSharedWithGroups(map[int]int{})),
+			},
+			want: want{
+				cr:  project(withSharedWithGroups(map[int]int{}), withLastError("Other", "boom")),
+				err: errors.Wrapf(errBoom, errUnshareFailed, 1),
+			},
+		},
 		"FailedEdit": {
 			args: args{
 				project: &fake.MockClient{
@@ -644,20 +1193,20 @@ func TestUpdate(t *testing.T) {
 				cr: project(withStatus(v1alpha1.ProjectObservation{ID: 1234})),
 			},
 			want: want{
-				cr:  project(withStatus(v1alpha1.ProjectObservation{ID: 1234})),
+				cr:  project(withStatus(v1alpha1.ProjectObservation{ID: 1234}), withLastError("Other", "boom")),
 				err: errors.Wrap(errBoom, errUpdateFailed),
 			},
 		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.project}
+			e := &external{kube: tc.kube, client: tc.project, recorder: event.NewNopRecorder()}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
-			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(), cmpopts.IgnoreFields(common.LastError{}, "Time")); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
 			if diff := cmp.Diff(tc.want.result, o); diff != "" {
@@ -700,6 +1249,59 @@ func TestDelete(t *testing.T) {
 				err: nil,
 			},
 		},
+		"SuccessfulArchive": {
+			args: args{
+				project: &fake.MockClient{
+					MockArchiveProject: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{Archived: true}, &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withExternalName("0"), withDeletionMode(v1alpha1.DeletionModeArchive)),
+			},
+			want: want{
+				cr: project(withExternalName("0"), withDeletionMode(v1alpha1.DeletionModeArchive)),
+			},
+		},
+		"PermanentDeletionMarksFirst": {
+			args: args{
+				project: &fake.MockClient{
+					MockDeleteProject: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						if len(options) != 1 {
+							return nil, errBoom
+						}
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: project(withExternalName("0"), withDeletionMode(v1alpha1.DeletionModePermanent)),
+			},
+			want: want{
+				cr: project(withExternalName("0"), withDeletionMode(v1alpha1.DeletionModePermanent)),
+			},
+		},
+		"PermanentDeletionOfMarkedProject": {
+			args: args{
+				project: &fake.MockClient{
+					MockDeleteProject: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						if len(options) != 2 {
+							return nil, errBoom
+						}
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: project(
+					withExternalName("0"),
+					withDeletionMode(v1alpha1.DeletionModePermanent),
+					withStatus(v1alpha1.ProjectObservation{PathWithNamespace: path, MarkedForDeletionAt: &metav1.Time{}}),
+				),
+			},
+			want: want{
+				cr: project(
+					withExternalName("0"),
+					withDeletionMode(v1alpha1.DeletionModePermanent),
+					withStatus(v1alpha1.ProjectObservation{PathWithNamespace: path, MarkedForDeletionAt: &metav1.Time{}}),
+				),
+			},
+		},
 		"FailedDeletion": {
 			args: args{
 				project: &fake.MockClient{
@@ -730,3 +1332,69 @@ func TestDelete(t *testing.T) {
 	}
 
 }
+
+func TestIsSharedWithGroupsUpToDate(t *testing.T) {
+	expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiresAtISO := gitlab.ISOTime(expiresAt)
+
+	cases := map[string]struct {
+		cr     *v1alpha1.Project
+		shares []projects.SharedWithGroup
+		want   bool
+	}{
+		"NotManaged": {
+			cr:     project(),
+			shares: shares(map[int]int{1: 30}),
+			want:   true,
+		},
+		"UpToDate": {
+			cr:     project(withSharedWithGroups(map[int]int{1: 30, 2: 40})),
+			shares: shares(map[int]int{1: 30, 2: 40}),
+			want:   true,
+		},
+		"MissingShare": {
+			cr:     project(withSharedWithGroups(map[int]int{1: 30, 2: 40})),
+			shares: shares(map[int]int{1: 30}),
+			want:   false,
+		},
+		"ExtraShare": {
+			cr:     project(withSharedWithGroups(map[int]int{})),
+			shares: shares(map[int]int{1: 30}),
+			want:   false,
+		},
+		"AccessLevelChanged": {
+			cr:     project(withSharedWithGroups(map[int]int{1: 30})),
+			shares: shares(map[int]int{1: 40}),
+			want:   false,
+		},
+		"ExpiresAtUpToDate": {
+			cr:     project(withSharedWithGroups(map[int]int{1: 30}), withShareExpiresAt(1, expiresAt.Add(12*time.Hour))),
+			shares: []projects.SharedWithGroup{{GroupID: 1, GroupAccessLevel: 30, ExpiresAt: &expiresAtISO}},
+			want:   true,
+		},
+		"ExpiresAtChanged": {
+			cr:     project(withSharedWithGroups(map[int]int{1: 30}), withShareExpiresAt(1, expiresAt.AddDate(0, 0, 1))),
+			shares: []projects.SharedWithGroup{{GroupID: 1, GroupAccessLevel: 30, ExpiresAt: &expiresAtISO}},
+			want:   false,
+		},
+		"ExpiresAtAdded": {
+			cr:     project(withSharedWithGroups(map[int]int{1: 30}), withShareExpiresAt(1, expiresAt)),
+			shares: shares(map[int]int{1: 30}),
+			want:   false,
+		},
+		"ExpiresAtRemoved": {
+			cr:     project(withSharedWithGroups(map[int]int{1: 30})),
+			shares: []projects.SharedWithGroup{{GroupID: 1, GroupAccessLevel: 30, ExpiresAt: &expiresAtISO}},
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isSharedWithGroupsUpToDate(&tc.cr.Spec.ForProvider, tc.shares)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protectedbranches
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
+)
+
+const (
+	errNotProtectedBranch = "managed resource is not a Gitlab protected branch custom resource"
+	errGetFailed          = "cannot get Gitlab protected branch"
+	errCreateFailed       = "cannot protect Gitlab branch"
+	errUpdateFailed       = "cannot update Gitlab protected branch"
+	errDeleteFailed       = "cannot unprotect Gitlab branch"
+	errProjectIDMissing   = "ProjectID is missing"
+)
+
+// SetupProtectedBranch adds a controller that reconciles ProtectedBranches.
+func SetupProtectedBranch(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ProtectedBranchKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(tracing.NewConnecter(clients.NewErrorConnecter(metrics.NewDriftConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewProtectedBranchClient}, v1alpha1.ProtectedBranchGroupKind), mgr.GetClient(), recorder), v1alpha1.ProtectedBranchGroupKind)),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(tracing.NewLogger(o.Logger.WithValues("controller", name), v1alpha1.ProtectedBranchGroupKind)),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ProtectedBranchGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ProtectedBranch{}).
+		Complete(tracing.NewReconciler(r, v1alpha1.ProtectedBranchGroupKind, o.Logger.WithValues("controller", name)))
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.ProtectedBranchClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ProtectedBranch)
+	if !ok {
+		return nil, errors.New(errNotProtectedBranch)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.ProtectedBranchClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ProtectedBranch)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotProtectedBranch)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	pb, res, err := e.client.GetProtectedBranch(
+		*cr.Spec.ForProvider.ProjectID,
+		cr.Spec.ForProvider.Name,
+		gitlab.WithContext(ctx))
+	if err != nil {
+		err = clients.Classify(res, err)
+		if clients.IsNotFound(err) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	projects.LateInitializeProtectedBranch(&cr.Spec.ForProvider, pb)
+
+	cr.Status.AtProvider.ID = &pb.ID
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        projects.IsProtectedBranchUpToDate(&cr.Spec.ForProvider, pb),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ProtectedBranch)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotProtectedBranch)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	_, res, err := e.client.ProtectRepositoryBranches(
+		*cr.Spec.ForProvider.ProjectID,
+		projects.GenerateProtectRepositoryBranchesOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx))
+	err = clients.Classify(res, err)
+	cr.Status.AtProvider.LastError = clients.GenerateLastError(err)
+
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ProtectedBranch)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotProtectedBranch)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+
+	// The access levels are diffed against their IDs in Gitlab, so the
+	// current state is fetched again rather than trusting the last
+	// observation.
+	pb, res, err := e.client.GetProtectedBranch(
+		*cr.Spec.ForProvider.ProjectID,
+		cr.Spec.ForProvider.Name,
+		gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(clients.Classify(res, err), errGetFailed)
+	}
+
+	_, res, err = e.client.UpdateProtectedBranch(
+		*cr.Spec.ForProvider.ProjectID,
+		cr.Spec.ForProvider.Name,
+		projects.GenerateUpdateProtectedBranchOptions(&cr.Spec.ForProvider, pb),
+		gitlab.WithContext(ctx),
+	)
+	err = clients.Classify(res, err)
+	cr.Status.AtProvider.LastError = clients.GenerateLastError(err)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ProtectedBranch)
+	if !ok {
+		return errors.New(errNotProtectedBranch)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	res, err := e.client.UnprotectRepositoryBranches(
+		*cr.Spec.ForProvider.ProjectID,
+		cr.Spec.ForProvider.Name,
+		gitlab.WithContext(ctx),
+	)
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, clients.Classify(res, err)), errDeleteFailed)
+}
@@ -0,0 +1,536 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protectedbranches
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom    = errors.New("boom")
+	projectID  = "1234"
+	branchName = "release/*"
+	pbID       = 5
+	maintainer = v1alpha1.AccessLevelValue(gitlab.MaintainerPermissions)
+	f          = false
+)
+
+type args struct {
+	client projects.ProtectedBranchClient
+	cr     *v1alpha1.ProtectedBranch
+}
+
+type protectedBranchModifier func(*v1alpha1.ProtectedBranch)
+
+func withConditions(c ...xpv1.Condition) protectedBranchModifier {
+	return func(r *v1alpha1.ProtectedBranch) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDefaultValues() protectedBranchModifier {
+	return func(r *v1alpha1.ProtectedBranch) {
+		r.Spec.ForProvider = v1alpha1.ProtectedBranchParameters{
+			ProjectID:                 &projectID,
+			Name:                      branchName,
+			PushAccessLevel:           &maintainer,
+			MergeAccessLevel:          &maintainer,
+			UnprotectAccessLevel:      &maintainer,
+			AllowForcePush:            &f,
+			CodeOwnerApprovalRequired: &f,
+		}
+	}
+}
+
+func withProjectID(pid string) protectedBranchModifier {
+	return func(r *v1alpha1.ProtectedBranch) {
+		r.Spec.ForProvider.ProjectID = &pid
+	}
+}
+
+func withName(name string) protectedBranchModifier {
+	return func(r *v1alpha1.ProtectedBranch) {
+		r.Spec.ForProvider.Name = name
+	}
+}
+
+func withAllowForcePush(b bool) protectedBranchModifier {
+	return func(r *v1alpha1.ProtectedBranch) {
+		r.Spec.ForProvider.AllowForcePush = &b
+	}
+}
+
+func withAllowedToPush(p ...v1alpha1.BranchPermission) protectedBranchModifier {
+	return func(r *v1alpha1.ProtectedBranch) {
+		r.Spec.ForProvider.AllowedToPush = p
+	}
+}
+
+func withID(id int) protectedBranchModifier {
+	return func(r *v1alpha1.ProtectedBranch) {
+		r.Status.AtProvider.ID = &id
+	}
+}
+
+func withLastError(reason, message string) protectedBranchModifier {
+	return func(r *v1alpha1.ProtectedBranch) {
+		r.Status.AtProvider.LastError = &common.LastError{Reason: reason, Message: message}
+	}
+}
+
+func protectedBranch(m ...protectedBranchModifier) *v1alpha1.ProtectedBranch {
+	cr := &v1alpha1.ProtectedBranch{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func role(id int) *projects.BranchAccessDescription {
+	return &projects.BranchAccessDescription{
+		BranchAccessDescription: gitlab.BranchAccessDescription{ID: id, AccessLevel: gitlab.MaintainerPermissions},
+	}
+}
+
+func observed() *projects.ProtectedBranch {
+	return &projects.ProtectedBranch{
+		ID:                    pbID,
+		Name:                  branchName,
+		PushAccessLevels:      []*projects.BranchAccessDescription{role(1)},
+		MergeAccessLevels:     []*projects.BranchAccessDescription{role(2)},
+		UnprotectAccessLevels: []*projects.BranchAccessDescription{role(3)},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ProtectedBranch
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProtectedBranch: func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*projects.ProtectedBranch, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+				},
+				cr: protectedBranch(withDefaultValues()),
+			},
+			want: want{
+				cr: protectedBranch(
+					withDefaultValues(),
+					withID(pbID),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProtectedBranch: func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*projects.ProtectedBranch, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+				},
+				cr: protectedBranch(
+					withProjectID(projectID),
+					withName(branchName),
+				),
+			},
+			want: want{
+				cr: protectedBranch(
+					withDefaultValues(),
+					withID(pbID),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProtectedBranch: func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*projects.ProtectedBranch, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+				},
+				cr: protectedBranch(
+					withDefaultValues(),
+					withAllowedToPush(v1alpha1.BranchPermission{DeployKeyID: ptr.To(3)}),
+				),
+			},
+			want: want{
+				cr: protectedBranch(
+					withDefaultValues(),
+					withAllowedToPush(v1alpha1.BranchPermission{DeployKeyID: ptr.To(3)}),
+					withID(pbID),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProtectedBranch: func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*projects.ProtectedBranch, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+				},
+				cr: protectedBranch(withDefaultValues()),
+			},
+			want: want{
+				cr: protectedBranch(withDefaultValues()),
+			},
+		},
+		"GetError": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProtectedBranch: func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*projects.ProtectedBranch, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: protectedBranch(withDefaultValues()),
+			},
+			want: want{
+				cr:  protectedBranch(withDefaultValues()),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: protectedBranch(withName(branchName)),
+			},
+			want: want{
+				cr:  protectedBranch(withName(branchName)),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ProtectedBranch
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockProtectRepositoryBranches: func(pid interface{}, opt *gitlab.ProtectRepositoryBranchesOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedBranch, *gitlab.Response, error) {
+						return &gitlab.ProtectedBranch{ID: pbID}, &gitlab.Response{}, nil
+					},
+				},
+				cr: protectedBranch(withDefaultValues()),
+			},
+			want: want{
+				cr: protectedBranch(
+					withDefaultValues(),
+					withConditions(xpv1.Creating()),
+				),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockProtectRepositoryBranches: func(pid interface{}, opt *gitlab.ProtectRepositoryBranchesOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedBranch, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: protectedBranch(withDefaultValues()),
+			},
+			want: want{
+				cr: protectedBranch(
+					withDefaultValues(),
+					withConditions(xpv1.Creating()),
+					withLastError(string(clients.ErrorKindOther), "boom"),
+				),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: protectedBranch(withName(branchName)),
+			},
+			want: want{
+				cr:  protectedBranch(withName(branchName)),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(), cmpopts.IgnoreFields(common.LastError{}, "Time")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ProtectedBranch
+		opt *gitlab.UpdateProtectedBranchOptions
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulUpdate": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProtectedBranch: func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*projects.ProtectedBranch, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+					MockUpdateProtectedBranch: func(pid interface{}, branch string, opt *gitlab.UpdateProtectedBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedBranch, *gitlab.Response, error) {
+						return &gitlab.ProtectedBranch{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: protectedBranch(
+					withDefaultValues(),
+					withAllowForcePush(true),
+					withAllowedToPush(v1alpha1.BranchPermission{DeployKeyID: ptr.To(3)}),
+				),
+			},
+			want: want{
+				cr: protectedBranch(
+					withDefaultValues(),
+					withAllowForcePush(true),
+					withAllowedToPush(v1alpha1.BranchPermission{DeployKeyID: ptr.To(3)}),
+				),
+				opt: &gitlab.UpdateProtectedBranchOptions{
+					AllowForcePush:            gitlab.Bool(true),
+					CodeOwnerApprovalRequired: &f,
+					AllowedToPush:             &[]*gitlab.BranchPermissionOptions{{DeployKeyID: gitlab.Int(3)}},
+				},
+			},
+		},
+		"FailedGet": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProtectedBranch: func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*projects.ProtectedBranch, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: protectedBranch(withDefaultValues()),
+			},
+			want: want{
+				cr:  protectedBranch(withDefaultValues()),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProtectedBranch: func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*projects.ProtectedBranch, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+					MockUpdateProtectedBranch: func(pid interface{}, branch string, opt *gitlab.UpdateProtectedBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedBranch, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: protectedBranch(withDefaultValues()),
+			},
+			want: want{
+				cr: protectedBranch(
+					withDefaultValues(),
+					withLastError(string(clients.ErrorKindOther), "boom"),
+				),
+				opt: &gitlab.UpdateProtectedBranchOptions{
+					AllowForcePush:            &f,
+					CodeOwnerApprovalRequired: &f,
+				},
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var opt *gitlab.UpdateProtectedBranchOptions
+			if mc, ok := tc.client.(*fake.MockClient); ok && mc.MockUpdateProtectedBranch != nil {
+				update := mc.MockUpdateProtectedBranch
+				mc.MockUpdateProtectedBranch = func(pid interface{}, branch string, o *gitlab.UpdateProtectedBranchOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProtectedBranch, *gitlab.Response, error) {
+					opt = o
+					return update(pid, branch, o, options...)
+				}
+			}
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(), cmpopts.IgnoreFields(common.LastError{}, "Time")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.opt, opt); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ProtectedBranch
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockUnprotectRepositoryBranches: func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: protectedBranch(
+					withDefaultValues(),
+					withConditions(xpv1.Available()),
+				),
+			},
+			want: want{
+				cr: protectedBranch(
+					withDefaultValues(),
+					withConditions(xpv1.Deleting()),
+				),
+			},
+		},
+		"AlreadyUnprotected": {
+			args: args{
+				client: &fake.MockClient{
+					MockUnprotectRepositoryBranches: func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+				},
+				cr: protectedBranch(
+					withDefaultValues(),
+					withConditions(xpv1.Available()),
+				),
+			},
+			want: want{
+				cr: protectedBranch(
+					withDefaultValues(),
+					withConditions(xpv1.Deleting()),
+				),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockUnprotectRepositoryBranches: func(pid interface{}, branch string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: protectedBranch(
+					withDefaultValues(),
+					withConditions(xpv1.Available()),
+				),
+			},
+			want: want{
+				cr: protectedBranch(
+					withDefaultValues(),
+					withConditions(xpv1.Deleting()),
+				),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
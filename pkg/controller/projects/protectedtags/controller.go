@@ -0,0 +1,210 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protectedtags
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
+)
+
+const (
+	errNotProtectedTag  = "managed resource is not a Gitlab protected tag custom resource"
+	errGetFailed        = "cannot get Gitlab protected tag"
+	errCreateFailed     = "cannot protect Gitlab tag"
+	errUpdateFailed     = "cannot update Gitlab protected tag"
+	errDeleteFailed     = "cannot unprotect Gitlab tag"
+	errProjectIDMissing = "ProjectID is missing"
+)
+
+// SetupProtectedTag adds a controller that reconciles ProtectedTags.
+func SetupProtectedTag(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ProtectedTagKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(tracing.NewConnecter(clients.NewErrorConnecter(metrics.NewDriftConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewProtectedTagClient}, v1alpha1.ProtectedTagGroupKind), mgr.GetClient(), recorder), v1alpha1.ProtectedTagGroupKind)),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(tracing.NewLogger(o.Logger.WithValues("controller", name), v1alpha1.ProtectedTagGroupKind)),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ProtectedTagGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ProtectedTag{}).
+		Complete(tracing.NewReconciler(r, v1alpha1.ProtectedTagGroupKind, o.Logger.WithValues("controller", name)))
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.ProtectedTagClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ProtectedTag)
+	if !ok {
+		return nil, errors.New(errNotProtectedTag)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.ProtectedTagClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ProtectedTag)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotProtectedTag)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	pt, res, err := e.client.GetProtectedTag(
+		*cr.Spec.ForProvider.ProjectID,
+		cr.Spec.ForProvider.Name,
+		gitlab.WithContext(ctx))
+	if err != nil {
+		err = clients.Classify(res, err)
+		if clients.IsNotFound(err) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	projects.LateInitializeProtectedTag(&cr.Spec.ForProvider, pt)
+
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        projects.IsProtectedTagUpToDate(&cr.Spec.ForProvider, pt),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ProtectedTag)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotProtectedTag)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	err := e.protect(ctx, cr)
+	cr.Status.AtProvider.LastError = clients.GenerateLastError(err)
+
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+	return managed.ExternalCreation{}, nil
+}
+
+// Update recreates the protection because Gitlab cannot edit a protected tag.
+// If protecting the tags again fails they stay unprotected until the next
+// reconcile finds them missing and creates the protection.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ProtectedTag)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotProtectedTag)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+
+	res, err := e.client.UnprotectRepositoryTags(
+		*cr.Spec.ForProvider.ProjectID,
+		cr.Spec.ForProvider.Name,
+		gitlab.WithContext(ctx),
+	)
+	if err = resource.Ignore(clients.IsNotFound, clients.Classify(res, err)); err == nil {
+		err = e.protect(ctx, cr)
+	}
+	cr.Status.AtProvider.LastError = clients.GenerateLastError(err)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ProtectedTag)
+	if !ok {
+		return errors.New(errNotProtectedTag)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	res, err := e.client.UnprotectRepositoryTags(
+		*cr.Spec.ForProvider.ProjectID,
+		cr.Spec.ForProvider.Name,
+		gitlab.WithContext(ctx),
+	)
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, clients.Classify(res, err)), errDeleteFailed)
+}
+
+func (e *external) protect(ctx context.Context, cr *v1alpha1.ProtectedTag) error {
+	_, res, err := e.client.ProtectRepositoryTags(
+		*cr.Spec.ForProvider.ProjectID,
+		projects.GenerateProtectRepositoryTagsOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	return clients.Classify(res, err)
+}
@@ -0,0 +1,433 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protectedtags
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom   = errors.New("boom")
+	projectID = "1234"
+	tagName   = "v*"
+	notFound  = &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+)
+
+type args struct {
+	client projects.ProtectedTagClient
+	cr     *v1alpha1.ProtectedTag
+}
+
+type protectedTagModifier func(*v1alpha1.ProtectedTag)
+
+func withConditions(c ...xpv1.Condition) protectedTagModifier {
+	return func(r *v1alpha1.ProtectedTag) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDefaultValues() protectedTagModifier {
+	return func(r *v1alpha1.ProtectedTag) {
+		r.Spec.ForProvider = v1alpha1.ProtectedTagParameters{
+			ProjectID: &projectID,
+			Name:      tagName,
+			CreateAccessLevels: []v1alpha1.TagAccessLevel{
+				{AccessLevel: ptr.To(v1alpha1.AccessLevelValue(gitlab.MaintainerPermissions))},
+			},
+		}
+	}
+}
+
+func withCreateAccessLevels(l ...v1alpha1.TagAccessLevel) protectedTagModifier {
+	return func(r *v1alpha1.ProtectedTag) {
+		r.Spec.ForProvider.CreateAccessLevels = l
+	}
+}
+
+func withLastError(reason, message string) protectedTagModifier {
+	return func(r *v1alpha1.ProtectedTag) {
+		r.Status.AtProvider.LastError = &common.LastError{Reason: reason, Message: message}
+	}
+}
+
+func protectedTag(m ...protectedTagModifier) *v1alpha1.ProtectedTag {
+	cr := &v1alpha1.ProtectedTag{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed() *projects.ProtectedTag {
+	return &projects.ProtectedTag{
+		Name: tagName,
+		CreateAccessLevels: []*projects.TagAccessDescription{
+			{TagAccessDescription: gitlab.TagAccessDescription{AccessLevel: gitlab.MaintainerPermissions}},
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ProtectedTag
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProtectedTag: func(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*projects.ProtectedTag, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+				},
+				cr: protectedTag(withDefaultValues()),
+			},
+			want: want{
+				cr: protectedTag(
+					withDefaultValues(),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProtectedTag: func(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*projects.ProtectedTag, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+				},
+				cr: protectedTag(
+					withDefaultValues(),
+					withCreateAccessLevels(),
+				),
+			},
+			want: want{
+				cr: protectedTag(
+					withDefaultValues(),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProtectedTag: func(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*projects.ProtectedTag, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+				},
+				cr: protectedTag(
+					withDefaultValues(),
+					withCreateAccessLevels(v1alpha1.TagAccessLevel{DeployKeyID: ptr.To(3)}),
+				),
+			},
+			want: want{
+				cr: protectedTag(
+					withDefaultValues(),
+					withCreateAccessLevels(v1alpha1.TagAccessLevel{DeployKeyID: ptr.To(3)}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProtectedTag: func(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*projects.ProtectedTag, *gitlab.Response, error) {
+						return nil, notFound, errBoom
+					},
+				},
+				cr: protectedTag(withDefaultValues()),
+			},
+			want: want{
+				cr: protectedTag(withDefaultValues()),
+			},
+		},
+		"GetError": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProtectedTag: func(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*projects.ProtectedTag, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: protectedTag(withDefaultValues()),
+			},
+			want: want{
+				cr:  protectedTag(withDefaultValues()),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ProtectedTag
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockProtectRepositoryTags: func(pid interface{}, opt *projects.ProtectRepositoryTagsOptions, options ...gitlab.RequestOptionFunc) (*projects.ProtectedTag, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+				},
+				cr: protectedTag(withDefaultValues()),
+			},
+			want: want{
+				cr: protectedTag(
+					withDefaultValues(),
+					withConditions(xpv1.Creating()),
+				),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockProtectRepositoryTags: func(pid interface{}, opt *projects.ProtectRepositoryTagsOptions, options ...gitlab.RequestOptionFunc) (*projects.ProtectedTag, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: protectedTag(withDefaultValues()),
+			},
+			want: want{
+				cr: protectedTag(
+					withDefaultValues(),
+					withConditions(xpv1.Creating()),
+					withLastError(string(clients.ErrorKindOther), "boom"),
+				),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(), cmpopts.IgnoreFields(common.LastError{}, "Time")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr    *v1alpha1.ProtectedTag
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		cr           *v1alpha1.ProtectedTag
+		unprotectRes *gitlab.Response
+		unprotect    error
+		protect      error
+		want         want
+	}{
+		"SuccessfulRecreate": {
+			cr: protectedTag(withDefaultValues()),
+			want: want{
+				cr:    protectedTag(withDefaultValues()),
+				calls: []string{"unprotect", "protect"},
+			},
+		},
+		"AlreadyUnprotected": {
+			cr:           protectedTag(withDefaultValues()),
+			unprotectRes: notFound,
+			unprotect:    errBoom,
+			want: want{
+				cr:    protectedTag(withDefaultValues()),
+				calls: []string{"unprotect", "protect"},
+			},
+		},
+		"FailedUnprotect": {
+			cr:        protectedTag(withDefaultValues()),
+			unprotect: errBoom,
+			want: want{
+				cr: protectedTag(
+					withDefaultValues(),
+					withLastError(string(clients.ErrorKindOther), "boom"),
+				),
+				calls: []string{"unprotect"},
+				err:   errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+		"FailedProtect": {
+			cr:      protectedTag(withDefaultValues()),
+			protect: errBoom,
+			want: want{
+				cr: protectedTag(
+					withDefaultValues(),
+					withLastError(string(clients.ErrorKindOther), "boom"),
+				),
+				calls: []string{"unprotect", "protect"},
+				err:   errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := &external{client: &fake.MockClient{
+				MockUnprotectRepositoryTags: func(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+					calls = append(calls, "unprotect")
+					return tc.unprotectRes, tc.unprotect
+				},
+				MockProtectRepositoryTags: func(pid interface{}, opt *projects.ProtectRepositoryTagsOptions, options ...gitlab.RequestOptionFunc) (*projects.ProtectedTag, *gitlab.Response, error) {
+					calls = append(calls, "protect")
+					return observed(), &gitlab.Response{}, tc.protect
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions(), cmpopts.IgnoreFields(common.LastError{}, "Time")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ProtectedTag
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockUnprotectRepositoryTags: func(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: protectedTag(withDefaultValues()),
+			},
+			want: want{
+				cr: protectedTag(
+					withDefaultValues(),
+					withConditions(xpv1.Deleting()),
+				),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockUnprotectRepositoryTags: func(pid interface{}, tag string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: protectedTag(withDefaultValues()),
+			},
+			want: want{
+				cr: protectedTag(
+					withDefaultValues(),
+					withConditions(xpv1.Deleting()),
+				),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pushrules
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/metrics"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/tracing"
+)
+
+const (
+	errNotPushRules     = "managed resource is not a Gitlab push rules custom resource"
+	errGetFailed        = "cannot get Gitlab push rules"
+	errCreateFailed     = "cannot add Gitlab push rules"
+	errUpdateFailed     = "cannot edit Gitlab push rules"
+	errDeleteFailed     = "cannot delete Gitlab push rules"
+	errProjectIDMissing = "ProjectID is missing"
+)
+
+// SetupPushRules adds a controller that reconciles PushRules.
+func SetupPushRules(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.PushRulesKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(tracing.NewConnecter(clients.NewErrorConnecter(metrics.NewDriftConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewPushRulesClient}, v1alpha1.PushRulesGroupKind), mgr.GetClient(), recorder), v1alpha1.PushRulesGroupKind)),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(tracing.NewLogger(o.Logger.WithValues("controller", name), v1alpha1.PushRulesGroupKind)),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.PushRulesGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.PushRules{}).
+		Complete(tracing.NewReconciler(r, v1alpha1.PushRulesGroupKind, o.Logger.WithValues("controller", name)))
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.PushRulesClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.PushRules)
+	if !ok {
+		return nil, errors.New(errNotPushRules)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.PushRulesClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.PushRules)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPushRules)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	rules, res, err := e.client.GetProjectPushRules(*cr.Spec.ForProvider.ProjectID, gitlab.WithContext(ctx))
+	if err != nil {
+		err = clients.Classify(res, err)
+		if clients.IsNotFound(err) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	// Gitlab responds with null rather than 404 if the project has no push
+	// rules.
+	if rules == nil || rules.ID == 0 {
+		return managed.ExternalObservation{}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	projects.LateInitializePushRules(&cr.Spec.ForProvider, rules)
+
+	lastError := cr.Status.AtProvider.LastError
+	cr.Status.AtProvider = projects.GeneratePushRulesObservation(rules)
+	cr.Status.AtProvider.LastError = lastError
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        isPushRulesUpToDate(&cr.Spec.ForProvider, rules),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.PushRules)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPushRules)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	_, res, err := e.client.AddProjectPushRule(
+		*cr.Spec.ForProvider.ProjectID,
+		projects.GenerateAddPushRuleOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx))
+	err = clients.Classify(res, err)
+	cr.Status.AtProvider.LastError = clients.GenerateLastError(err)
+
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.PushRules)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPushRules)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+
+	_, res, err := e.client.EditProjectPushRule(
+		*cr.Spec.ForProvider.ProjectID,
+		projects.GenerateEditPushRuleOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	err = clients.Classify(res, err)
+	cr.Status.AtProvider.LastError = clients.GenerateLastError(err)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.PushRules)
+	if !ok {
+		return errors.New(errNotPushRules)
+	}
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return errors.New(errProjectIDMissing)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	res, err := e.client.DeleteProjectPushRule(*cr.Spec.ForProvider.ProjectID, gitlab.WithContext(ctx))
+	return errors.Wrap(resource.Ignore(clients.IsNotFound, clients.Classify(res, err)), errDeleteFailed)
+}
+
+// isPushRulesUpToDate checks whether there is a change in any of the modifiable fields.
+func isPushRulesUpToDate(p *v1alpha1.PushRulesParameters, g *gitlab.ProjectPushRules) bool { // nolint:gocyclo
+	if p.CommitMessageRegex != nil && *p.CommitMessageRegex != g.CommitMessageRegex {
+		return false
+	}
+	if p.CommitMessageNegativeRegex != nil && *p.CommitMessageNegativeRegex != g.CommitMessageNegativeRegex {
+		return false
+	}
+	if p.BranchNameRegex != nil && *p.BranchNameRegex != g.BranchNameRegex {
+		return false
+	}
+	if p.AuthorEmailRegex != nil && *p.AuthorEmailRegex != g.AuthorEmailRegex {
+		return false
+	}
+	if p.FileNameRegex != nil && *p.FileNameRegex != g.FileNameRegex {
+		return false
+	}
+	if !clients.IsIntEqualToIntPtr(p.MaxFileSize, g.MaxFileSize) {
+		return false
+	}
+	if !clients.IsBoolEqualToBoolPtr(p.DenyDeleteTag, g.DenyDeleteTag) {
+		return false
+	}
+	if !clients.IsBoolEqualToBoolPtr(p.MemberCheck, g.MemberCheck) {
+		return false
+	}
+	if !clients.IsBoolEqualToBoolPtr(p.PreventSecrets, g.PreventSecrets) {
+		return false
+	}
+	if !clients.IsBoolEqualToBoolPtr(p.CommitCommitterCheck, g.CommitCommitterCheck) {
+		return false
+	}
+	if !clients.IsBoolEqualToBoolPtr(p.RejectUnsignedCommits, g.RejectUnsignedCommits) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,482 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pushrules
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom      = errors.New("boom")
+	projectID    = "1234"
+	rulesID      = 1
+	messageRegex = "^JIRA-"
+	maxFileSize  = 100
+	t            = true
+	f            = false
+)
+
+type args struct {
+	client projects.PushRulesClient
+	cr     *v1alpha1.PushRules
+}
+
+type pushRulesModifier func(*v1alpha1.PushRules)
+
+func withConditions(c ...xpv1.Condition) pushRulesModifier {
+	return func(r *v1alpha1.PushRules) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDefaultValues() pushRulesModifier {
+	return func(r *v1alpha1.PushRules) {
+		r.Spec.ForProvider = v1alpha1.PushRulesParameters{
+			ProjectID:             &projectID,
+			CommitMessageRegex:    &messageRegex,
+			MaxFileSize:           &maxFileSize,
+			DenyDeleteTag:         &t,
+			MemberCheck:           &f,
+			PreventSecrets:        &t,
+			CommitCommitterCheck:  &f,
+			RejectUnsignedCommits: &f,
+		}
+	}
+}
+
+func withProjectID(pid string) pushRulesModifier {
+	return func(r *v1alpha1.PushRules) {
+		r.Spec.ForProvider.ProjectID = &pid
+	}
+}
+
+func withRejectUnsignedCommits(b bool) pushRulesModifier {
+	return func(r *v1alpha1.PushRules) {
+		r.Spec.ForProvider.RejectUnsignedCommits = &b
+	}
+}
+
+func withObservation() pushRulesModifier {
+	return func(r *v1alpha1.PushRules) {
+		r.Status.AtProvider.ID = &rulesID
+	}
+}
+
+func withLastError(reason, message string) pushRulesModifier {
+	return func(r *v1alpha1.PushRules) {
+		r.Status.AtProvider.LastError = &common.LastError{Reason: reason, Message: message}
+	}
+}
+
+func pushRules(m ...pushRulesModifier) *v1alpha1.PushRules {
+	cr := &v1alpha1.PushRules{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed() *gitlab.ProjectPushRules {
+	return &gitlab.ProjectPushRules{
+		ID:                 rulesID,
+		CommitMessageRegex: messageRegex,
+		MaxFileSize:        maxFileSize,
+		DenyDeleteTag:      true,
+		PreventSecrets:     true,
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.PushRules
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProjectPushRules: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+				},
+				cr: pushRules(withDefaultValues()),
+			},
+			want: want{
+				cr: pushRules(
+					withDefaultValues(),
+					withObservation(),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProjectPushRules: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+				},
+				cr: pushRules(withProjectID(projectID)),
+			},
+			want: want{
+				cr: pushRules(
+					withDefaultValues(),
+					withObservation(),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProjectPushRules: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+				},
+				cr: pushRules(
+					withDefaultValues(),
+					withRejectUnsignedCommits(true),
+					withLastError(string(clients.ErrorKindOther), "boom"),
+				),
+			},
+			want: want{
+				cr: pushRules(
+					withDefaultValues(),
+					withRejectUnsignedCommits(true),
+					withObservation(),
+					withLastError(string(clients.ErrorKindOther), "boom"),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NoPushRules": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProjectPushRules: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+						return &gitlab.ProjectPushRules{}, &gitlab.Response{}, nil
+					},
+				},
+				cr: pushRules(withDefaultValues()),
+			},
+			want: want{
+				cr: pushRules(withDefaultValues()),
+			},
+		},
+		"ProjectNotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProjectPushRules: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+				},
+				cr: pushRules(withDefaultValues()),
+			},
+			want: want{
+				cr: pushRules(withDefaultValues()),
+			},
+		},
+		"GetError": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetProjectPushRules: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: pushRules(withDefaultValues()),
+			},
+			want: want{
+				cr:  pushRules(withDefaultValues()),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: pushRules(),
+			},
+			want: want{
+				cr:  pushRules(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.PushRules
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockAddProjectPushRule: func(pid interface{}, opt *gitlab.AddProjectPushRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+				},
+				cr: pushRules(withDefaultValues()),
+			},
+			want: want{
+				cr: pushRules(
+					withDefaultValues(),
+					withConditions(xpv1.Creating()),
+				),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockAddProjectPushRule: func(pid interface{}, opt *gitlab.AddProjectPushRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: pushRules(withDefaultValues()),
+			},
+			want: want{
+				cr: pushRules(
+					withDefaultValues(),
+					withConditions(xpv1.Creating()),
+					withLastError(string(clients.ErrorKindOther), "boom"),
+				),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(), cmpopts.IgnoreFields(common.LastError{}, "Time")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.PushRules
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulUpdate": {
+			args: args{
+				client: &fake.MockClient{
+					MockEditProjectPushRule: func(pid interface{}, opt *gitlab.EditProjectPushRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+						return observed(), &gitlab.Response{}, nil
+					},
+				},
+				cr: pushRules(
+					withDefaultValues(),
+					withLastError(string(clients.ErrorKindOther), "boom"),
+				),
+			},
+			want: want{
+				cr: pushRules(withDefaultValues()),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				client: &fake.MockClient{
+					MockEditProjectPushRule: func(pid interface{}, opt *gitlab.EditProjectPushRuleOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectPushRules, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: pushRules(withDefaultValues()),
+			},
+			want: want{
+				cr: pushRules(
+					withDefaultValues(),
+					withLastError(string(clients.ErrorKindOther), "boom"),
+				),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(), cmpopts.IgnoreFields(common.LastError{}, "Time")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.PushRules
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteProjectPushRule: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: pushRules(withDefaultValues()),
+			},
+			want: want{
+				cr: pushRules(
+					withDefaultValues(),
+					withConditions(xpv1.Deleting()),
+				),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteProjectPushRule: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: pushRules(withDefaultValues()),
+			},
+			want: want{
+				cr: pushRules(
+					withDefaultValues(),
+					withConditions(xpv1.Deleting()),
+				),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsPushRulesUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.PushRulesParameters
+		want bool
+	}{
+		"Unset":                      {p: &v1alpha1.PushRulesParameters{}, want: true},
+		"CommitMessageRegex":         {p: &v1alpha1.PushRulesParameters{CommitMessageRegex: ptr.To("^FIX-")}, want: false},
+		"ClearedCommitMessageRegex":  {p: &v1alpha1.PushRulesParameters{CommitMessageRegex: ptr.To("")}, want: false},
+		"CommitMessageNegativeRegex": {p: &v1alpha1.PushRulesParameters{CommitMessageNegativeRegex: ptr.To("ssh://")}, want: false},
+		"BranchNameRegex":            {p: &v1alpha1.PushRulesParameters{BranchNameRegex: ptr.To("^feature/")}, want: false},
+		"AuthorEmailRegex":           {p: &v1alpha1.PushRulesParameters{AuthorEmailRegex: ptr.To("@example.com$")}, want: false},
+		"FileNameRegex":              {p: &v1alpha1.PushRulesParameters{FileNameRegex: ptr.To("(jar|exe)$")}, want: false},
+		"EmptyFileNameRegex":         {p: &v1alpha1.PushRulesParameters{FileNameRegex: ptr.To("")}, want: true},
+		"MaxFileSize":                {p: &v1alpha1.PushRulesParameters{MaxFileSize: ptr.To(0)}, want: false},
+		"DenyDeleteTag":              {p: &v1alpha1.PushRulesParameters{DenyDeleteTag: ptr.To(false)}, want: false},
+		"MemberCheck":                {p: &v1alpha1.PushRulesParameters{MemberCheck: ptr.To(true)}, want: false},
+		"PreventSecrets":             {p: &v1alpha1.PushRulesParameters{PreventSecrets: ptr.To(false)}, want: false},
+		"CommitCommitterCheck":       {p: &v1alpha1.PushRulesParameters{CommitCommitterCheck: ptr.To(true)}, want: false},
+		"RejectUnsignedCommits":      {p: &v1alpha1.PushRulesParameters{RejectUnsignedCommits: ptr.To(true)}, want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, isPushRulesUpToDate(tc.p, observed())); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
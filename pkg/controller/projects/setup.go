@@ -22,12 +22,18 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/accesstokens"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/approvalrules"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/approvalsettings"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/branches"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/deploykeys"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/deploytokens"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/hooks"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/members"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/pipelineschedules"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/protectedbranches"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/protectedtags"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/pushrules"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/variables"
 )
 
@@ -42,6 +48,12 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		variables.SetupVariable,
 		deploykeys.SetupDeployKey,
 		pipelineschedules.SetupPipelineSchedule,
+		protectedbranches.SetupProtectedBranch,
+		protectedtags.SetupProtectedTag,
+		pushrules.SetupPushRules,
+		approvalrules.SetupApprovalRule,
+		approvalsettings.SetupApprovalSettings,
+		branches.SetupBranch,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/xanzy/go-gitlab"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -31,6 +32,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
+	"github.com/crossplane-contrib/provider-gitlab/apis/common"
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
@@ -133,6 +135,12 @@ func withEnvironmentScope(scope string) variableModifier {
 	}
 }
 
+func withLastError(reason, message string) variableModifier {
+	return func(r *v1alpha1.Variable) {
+		r.Status.AtProvider.LastError = &common.LastError{Reason: reason, Message: message}
+	}
+}
+
 func variable(m ...variableModifier) *v1alpha1.Variable {
 	cr := &v1alpha1.Variable{}
 	for _, f := range m {
@@ -440,6 +448,7 @@ func TestCreate(t *testing.T) {
 				cr: variable(
 					withDefaultValues(),
 					withConditions(xpv1.Creating()),
+					withLastError("Other", "boom"),
 				),
 				err: errors.Wrap(errBoom, errCreateFailed),
 			},
@@ -532,7 +541,7 @@ func TestCreate(t *testing.T) {
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
-			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(), cmpopts.IgnoreFields(common.LastError{}, "Time")); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
 			if diff := cmp.Diff(tc.want.result, o); diff != "" {
@@ -588,6 +597,7 @@ func TestUpdate(t *testing.T) {
 				cr: variable(
 					withKey(variableKey),
 					withProjectID(projectID),
+					withLastError("Other", "boom"),
 				),
 				err: errors.Wrap(errBoom, errUpdateFailed),
 			},
@@ -688,7 +698,7 @@ func TestUpdate(t *testing.T) {
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
-			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(), cmpopts.IgnoreFields(common.LastError{}, "Time")); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
 			if diff := cmp.Diff(tc.want.result, o); diff != "" {
@@ -21,6 +21,7 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/config"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/groups"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects"
@@ -30,7 +31,9 @@ import (
 // them to the supplied manager.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	for _, setup := range []func(ctrl.Manager, controller.Options) error{
+		clients.SetupConfigCache,
 		config.Setup,
+		config.SetupHealth,
 		groups.Setup,
 		projects.Setup,
 	} {
@@ -0,0 +1,134 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics contains the Prometheus metrics of the provider. They are
+// registered on the controller-runtime metrics registry, which is served by
+// the controller manager.
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const namespace = "provider_gitlab"
+
+var (
+	apiRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "api_requests_total",
+		Help:      "Number of requests sent to the Gitlab API.",
+	}, []string{"endpoint", "method", "code", "providerconfig"})
+
+	apiRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "api_request_duration_seconds",
+		Help:      "Latency of requests sent to the Gitlab API.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"endpoint", "method", "code", "providerconfig"})
+
+	driftObservations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "drift_observations_total",
+		Help:      "Number of observations of managed resources that were not up to date.",
+	}, []string{"kind"})
+
+	driftedResources = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "drifted_resources",
+		Help:      "Number of managed resources that were not up to date when last observed.",
+	}, []string{"kind"})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(apiRequests, apiRequestDuration, driftObservations, driftedResources)
+}
+
+// ObserveAPIRequest records a request to the Gitlab API.
+func ObserveAPIRequest(providerConfig, method, endpoint, code string, d time.Duration) {
+	apiRequests.WithLabelValues(endpoint, method, code, providerConfig).Inc()
+	apiRequestDuration.WithLabelValues(endpoint, method, code, providerConfig).Observe(d.Seconds())
+}
+
+// drifted holds the names of the managed resources of each kind that were not
+// up to date when last observed.
+var drifted = struct {
+	sync.Mutex
+	m map[string]map[string]struct{}
+}{m: map[string]map[string]struct{}{}}
+
+// ObserveDrift records whether the named managed resource of the supplied kind
+// was up to date when it was observed.
+func ObserveDrift(kind, name string, upToDate bool) {
+	if !upToDate {
+		driftObservations.WithLabelValues(kind).Inc()
+	}
+
+	drifted.Lock()
+	defer drifted.Unlock()
+	names, ok := drifted.m[kind]
+	if !ok {
+		names = map[string]struct{}{}
+		drifted.m[kind] = names
+	}
+	if upToDate {
+		delete(names, name)
+	} else {
+		names[name] = struct{}{}
+	}
+	driftedResources.WithLabelValues(kind).Set(float64(len(names)))
+}
+
+// NewDriftConnecter wraps the supplied ExternalConnecter so that the
+// observations of the ExternalClients it returns are recorded as drift metrics
+// of the supplied kind.
+func NewDriftConnecter(c managed.ExternalConnecter, kind string) managed.ExternalConnecter {
+	return &driftConnecter{ExternalConnecter: c, kind: kind}
+}
+
+type driftConnecter struct {
+	managed.ExternalConnecter
+	kind string
+}
+
+func (c *driftConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &driftExternal{ExternalClient: e, kind: c.kind}, nil
+}
+
+type driftExternal struct {
+	managed.ExternalClient
+	kind string
+}
+
+func (e *driftExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	if err == nil {
+		// Resources that don't exist yet or anymore are not drifting.
+		ObserveDrift(e.kind, mg.GetName(), !o.ResourceExists || o.ResourceUpToDate)
+	}
+	return o, err
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+func TestObserveAPIRequest(t *testing.T) {
+	ObserveAPIRequest("default", "GET", "/projects/:id", "200", time.Second)
+	ObserveAPIRequest("default", "GET", "/projects/:id", "200", time.Second)
+
+	if diff := cmp.Diff(2.0, testutil.ToFloat64(apiRequests.WithLabelValues("/projects/:id", "GET", "200", "default"))); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestDriftConnecter(t *testing.T) {
+	type observation struct {
+		name string
+		o    managed.ExternalObservation
+	}
+
+	cases := map[string]struct {
+		observations []observation
+		wantTotal    float64
+		wantDrifted  float64
+	}{
+		"UpToDate": {
+			observations: []observation{
+				{name: "a", o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			},
+		},
+		"NotUpToDate": {
+			observations: []observation{
+				{name: "a", o: managed.ExternalObservation{ResourceExists: true}},
+				{name: "a", o: managed.ExternalObservation{ResourceExists: true}},
+				{name: "b", o: managed.ExternalObservation{ResourceExists: true}},
+			},
+			wantTotal:   3,
+			wantDrifted: 2,
+		},
+		"Reconciled": {
+			observations: []observation{
+				{name: "a", o: managed.ExternalObservation{ResourceExists: true}},
+				{name: "a", o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			},
+			wantTotal: 1,
+		},
+		"Deleted": {
+			observations: []observation{
+				{name: "a", o: managed.ExternalObservation{ResourceExists: true}},
+				{name: "a", o: managed.ExternalObservation{}},
+			},
+			wantTotal: 1,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			for _, ob := range tc.observations {
+				ob := ob
+				e := managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						return ob.o, nil
+					},
+				}
+				c := NewDriftConnecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+					return e, nil
+				}), name)
+
+				mg := &fake.Managed{}
+				mg.SetName(ob.name)
+				ext, err := c.Connect(context.Background(), mg)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if _, err := ext.Observe(context.Background(), mg); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if diff := cmp.Diff(tc.wantTotal, testutil.ToFloat64(driftObservations.WithLabelValues(name))); diff != "" {
+				t.Errorf("total: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantDrifted, testutil.ToFloat64(driftedResources.WithLabelValues(name))); diff != "" {
+				t.Errorf("drifted: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
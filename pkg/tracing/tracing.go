@@ -0,0 +1,233 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing contains the OpenTelemetry instrumentation of the provider.
+// Spans are only recorded once Setup was called; until then the global no-op
+// tracer provider is used.
+package tracing
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	serviceName = "provider-gitlab"
+	tracerName  = "github.com/crossplane-contrib/provider-gitlab"
+
+	errCreateExporter = "cannot create OTLP trace exporter"
+	errCreateResource = "cannot create OpenTelemetry resource"
+)
+
+// Span attributes common to the spans of the provider.
+const (
+	AttributeKind         = attribute.Key("crossplane.kind")
+	AttributeName         = attribute.Key("crossplane.name")
+	AttributeExternalName = attribute.Key("crossplane.external_name")
+)
+
+// Setup installs a global tracer provider that exports spans over OTLP/HTTP.
+// The exporter is configured through the standard OTEL_EXPORTER_OTLP_*
+// environment variables. The returned function flushes and stops the
+// exporter.
+func Setup(ctx context.Context) (func(context.Context) error, error) {
+	exp, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateExporter)
+	}
+	res, err := sdkresource.Merge(sdkresource.Default(), sdkresource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName)))
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateResource)
+	}
+	tp := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exp), sdktrace.WithResource(res))
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return tp.Shutdown, nil
+}
+
+// Tracer returns the tracer of the provider.
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// End records the supplied error, if any, on the supplied span and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// WithTraceIDs returns the supplied logger with the IDs of the span in the
+// supplied context, if any, so that its log lines can be correlated with the
+// trace.
+func WithTraceIDs(ctx context.Context, log logging.Logger) logging.Logger {
+	return withSpanContext(trace.SpanContextFromContext(ctx), log)
+}
+
+func withSpanContext(sc trace.SpanContext, log logging.Logger) logging.Logger {
+	if !sc.IsValid() {
+		return log
+	}
+	return log.WithValues("trace-id", sc.TraceID().String(), "span-id", sc.SpanID().String())
+}
+
+// reconciles holds the span context of every request that is being
+// reconciled, by kind. A controller never reconciles the same request
+// concurrently.
+var reconciles sync.Map
+
+type reconcileKey struct {
+	kind string
+	req  reconcile.Request
+}
+
+// NewLogger wraps the supplied logger of a reconciler of the supplied kind,
+// whose Reconciler is wrapped by NewReconciler, but which is not handed the
+// context of a reconcile to pass to WithTraceIDs. Loggers derived from it with
+// the reconciled request as "request" value add the IDs of the span of the
+// reconcile to their log lines.
+func NewLogger(log logging.Logger, kind string) logging.Logger {
+	return &logger{Logger: log, kind: kind}
+}
+
+type logger struct {
+	logging.Logger
+	kind string
+}
+
+func (l *logger) WithValues(keysAndValues ...any) logging.Logger {
+	log := l.Logger.WithValues(keysAndValues...)
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		req, ok := keysAndValues[i+1].(reconcile.Request)
+		if keysAndValues[i] != "request" || !ok {
+			continue
+		}
+		if sc, ok := reconciles.Load(reconcileKey{kind: l.kind, req: req}); ok {
+			return withSpanContext(sc.(trace.SpanContext), log)
+		}
+		return log
+	}
+	return &logger{Logger: log, kind: l.kind}
+}
+
+// NewReconciler wraps the supplied Reconciler so that every reconcile of the
+// supplied kind is recorded as a span, which is the parent of all spans
+// created while reconciling.
+func NewReconciler(r reconcile.Reconciler, kind string, log logging.Logger) reconcile.Reconciler {
+	return &reconciler{Reconciler: r, kind: kind, log: log}
+}
+
+type reconciler struct {
+	reconcile.Reconciler
+	kind string
+	log  logging.Logger
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	ctx, span := Tracer().Start(ctx, "Reconcile "+r.kind, trace.WithAttributes(AttributeKind.String(r.kind), AttributeName.String(req.Name)))
+	key := reconcileKey{kind: r.kind, req: req}
+	reconciles.Store(key, span.SpanContext())
+	defer reconciles.Delete(key)
+	start := time.Now()
+	res, err := r.Reconciler.Reconcile(ctx, req)
+	WithTraceIDs(ctx, r.log).Debug("Reconcile finished", "request", req, "duration", time.Since(start), "requeue-after", res.RequeueAfter, "error", err)
+	End(span, err)
+	return res, err
+}
+
+// NewConnecter wraps the supplied ExternalConnecter so that connecting to
+// Gitlab and every operation of the ExternalClients it returns are recorded
+// as spans.
+func NewConnecter(c managed.ExternalConnecter, kind string) managed.ExternalConnecter {
+	return &connecter{ExternalConnecter: c, kind: kind}
+}
+
+type connecter struct {
+	managed.ExternalConnecter
+	kind string
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ctx, span := c.start(ctx, "Connect", mg)
+	e, err := c.ExternalConnecter.Connect(ctx, mg)
+	End(span, err)
+	if err != nil {
+		return nil, err
+	}
+	return &external{ExternalClient: e, connecter: c}, nil
+}
+
+func (c *connecter) start(ctx context.Context, op string, mg resource.Managed) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, c.kind+"."+op, trace.WithAttributes(
+		AttributeKind.String(c.kind),
+		AttributeName.String(mg.GetName()),
+		AttributeExternalName.String(meta.GetExternalName(mg)),
+	))
+}
+
+type external struct {
+	managed.ExternalClient
+	connecter *connecter
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	ctx, span := e.connecter.start(ctx, "Observe", mg)
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	span.SetAttributes(attribute.Bool("crossplane.resource_exists", o.ResourceExists), attribute.Bool("crossplane.resource_up_to_date", o.ResourceUpToDate))
+	End(span, err)
+	return o, err
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	ctx, span := e.connecter.start(ctx, "Create", mg)
+	c, err := e.ExternalClient.Create(ctx, mg)
+	End(span, err)
+	return c, err
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	ctx, span := e.connecter.start(ctx, "Update", mg)
+	u, err := e.ExternalClient.Update(ctx, mg)
+	End(span, err)
+	return u, err
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	ctx, span := e.connecter.start(ctx, "Delete", mg)
+	err := e.ExternalClient.Delete(ctx, mg)
+	End(span, err)
+	return err
+}
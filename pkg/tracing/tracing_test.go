@@ -0,0 +1,146 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+var errBoom = errors.New("boom")
+
+// recordSpans installs a tracer provider that records all spans for the
+// duration of the test.
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	sr := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+	return sr
+}
+
+type span struct {
+	Name   string
+	Parent string
+	Status codes.Code
+}
+
+func TestTracing(t *testing.T) {
+	sr := recordSpans(t)
+
+	e := &managed.ExternalClientFns{
+		ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+			return managed.ExternalObservation{ResourceExists: true}, nil
+		},
+		UpdateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+			return managed.ExternalUpdate{}, errBoom
+		},
+	}
+	c := NewConnecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return e, nil
+	}), "Kind")
+	r := NewReconciler(reconcile.Func(func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+		mg := &fake.Managed{}
+		ext, err := c.Connect(ctx, mg)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if _, err := ext.Observe(ctx, mg); err != nil {
+			return reconcile.Result{}, err
+		}
+		_, err = ext.Update(ctx, mg)
+		return reconcile.Result{}, err
+	}), "Kind", logging.NewNopLogger())
+
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{}); !errors.Is(err, errBoom) {
+		t.Fatalf("Reconcile: want %v, got %v", errBoom, err)
+	}
+
+	names := map[string]string{}
+	for _, s := range sr.Ended() {
+		names[s.SpanContext().SpanID().String()] = s.Name()
+	}
+	got := []span{}
+	for _, s := range sr.Ended() {
+		got = append(got, span{Name: s.Name(), Parent: names[s.Parent().SpanID().String()], Status: s.Status().Code})
+	}
+	want := []span{
+		{Name: "Kind.Connect", Parent: "Reconcile Kind", Status: codes.Unset},
+		{Name: "Kind.Observe", Parent: "Reconcile Kind", Status: codes.Unset},
+		{Name: "Kind.Update", Parent: "Reconcile Kind", Status: codes.Error},
+		{Name: "Reconcile Kind", Status: codes.Error},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("spans: -want, +got:\n%s", diff)
+	}
+}
+
+// valuesLogger records the values of the lines it logs.
+type valuesLogger struct {
+	values []any
+	lines  *[][]any
+}
+
+func (l valuesLogger) Info(_ string, keysAndValues ...any) {
+	*l.lines = append(*l.lines, append(append([]any{}, l.values...), keysAndValues...))
+}
+
+func (l valuesLogger) Debug(msg string, keysAndValues ...any) { l.Info(msg, keysAndValues...) }
+
+func (l valuesLogger) WithValues(keysAndValues ...any) logging.Logger {
+	return valuesLogger{values: append(append([]any{}, l.values...), keysAndValues...), lines: l.lines}
+}
+
+func TestNewLogger(t *testing.T) {
+	sr := recordSpans(t)
+
+	var lines [][]any
+	log := NewLogger(valuesLogger{lines: &lines}, "Kind")
+	req := reconcile.Request{}
+	r := NewReconciler(reconcile.Func(func(_ context.Context, req reconcile.Request) (reconcile.Result, error) {
+		log.WithValues("controller", "kind").WithValues("request", req).Debug("Reconciling")
+		return reconcile.Result{}, nil
+	}), "Kind", logging.NewNopLogger())
+
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+	log.WithValues("request", req).Debug("Not reconciling")
+
+	sc := sr.Ended()[0].SpanContext()
+	want := [][]any{
+		{"controller", "kind", "request", req, "trace-id", sc.TraceID().String(), "span-id", sc.SpanID().String()},
+		{"request", req},
+	}
+	if diff := cmp.Diff(want, lines); diff != "" {
+		t.Errorf("lines: -want, +got:\n%s", diff)
+	}
+}